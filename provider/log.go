@@ -21,6 +21,8 @@ import (
 
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/contract"
+
+	"github.com/pulumi/pulumi-terraform/v6/provider/state_reference"
 )
 
 // LogRedirector creates a new redirection writer that takes as input plugin stderr output, and routes it to the
@@ -29,13 +31,15 @@ type LogRedirector struct {
 	enabled bool                          // true if standard logging is on; false for debug-only.
 	writers map[string]func(string) error // the writers for certain labels.
 	buffer  []byte                        // a buffer that holds up to a line of output.
+	redact  func(string) string           // scrubs the secrets of the call from a line.
 }
 
-// NewTerraformLogRedirector returns a new LogRedirector with the (unexported) writers field
-// set to the given map.
+// NewTerraformLogRedirector returns a new LogRedirector writing to the logger of ctx, and redacting the secrets
+// of the call it serves.
 func NewTerraformLogRedirector(ctx context.Context) *LogRedirector {
 	logger := p.GetLogger(ctx)
 	return &LogRedirector{
+		redact: func(s string) string { return provider.RedactSecrets(ctx, s) },
 		writers: map[string]func(string) error{
 			tfTracePrefix: func(msg string) error { logger.Debug(msg); return nil },
 			tfDebugPrefix: func(msg string) error { logger.Debug(msg); return nil },
@@ -69,7 +73,7 @@ func (lr *LogRedirector) Write(p []byte) (n int, err error) {
 
 	// If a line starts with [TRACE], [DEBUG], or [INFO], then we emit to a debug log entry.  If a line starts with
 	// [WARN], we emit a warning.  If a line starts with [ERROR], on the other hand, we emit a normal stderr line.
-	// All others simply get redirected to stdout as normal output.
+	// All others simply get redirected to stdout as normal output.  Secrets are redacted from every line.
 	for len(p) > 0 {
 		adv, tok, err := bufio.ScanLines(p, false)
 		if err != nil {
@@ -93,7 +97,8 @@ func (lr *LogRedirector) Write(p []byte) (n int, err error) {
 		if start := strings.IndexRune(s, '['); start != -1 {
 			if end := strings.Index(s[start:], "] "); end != -1 {
 				label = s[start : start+end+1]
-				s = s[start+end+2:] // skip past the "] " (notice the space)
+				// Skip past the "] ", and the padding hclog adds after short labels.
+				s = strings.TrimLeft(s[start+end+2:], " ")
 			}
 		}
 		w, has := lr.writers[label]
@@ -102,7 +107,7 @@ func (lr *LogRedirector) Write(p []byte) (n int, err error) {
 			w = lr.writers[tfDebugPrefix]
 			contract.Assertf(w != nil, "No writer should be nil")
 		}
-		if err := w(s); err != nil {
+		if err := w(lr.redact(s)); err != nil {
 			return written, err
 		}
 
@@ -114,3 +119,21 @@ func (lr *LogRedirector) Write(p []byte) (n int, err error) {
 
 	return written, nil
 }
+
+// withTerraformLogs wraps a provider method so the log messages Terraform's backends
+// emit for it are forwarded to the logger of that call. The call runs with a redactor
+// of its own, which the secrets of its arguments are added to.
+func withTerraformLogs[Req, Resp any](
+	f func(context.Context, Req) (Resp, error),
+) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, req Req) (Resp, error) {
+		ctx = provider.WithSecretRedaction(ctx)
+		lr := NewTerraformLogRedirector(ctx)
+		if provider.ShowTerraformLogs() {
+			lr.Enable()
+		}
+		ctx, stop := provider.RedirectTerraformLogs(ctx, lr)
+		defer stop()
+		return f(ctx, req)
+	}
+}
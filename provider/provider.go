@@ -16,6 +16,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	p "github.com/pulumi/pulumi-go-provider"
@@ -88,10 +89,26 @@ func NewProvider() p.Provider {
 		},
 		Functions: []infer.InferredFunction{
 			infer.Function(&provider.GetAzureRMReference{}),
+			infer.Function(&provider.GetBackendReference{}),
+			infer.Function(&provider.GetCloudReference{}),
+			infer.Function(&provider.GetConsulReference{}),
+			infer.Function(&provider.GetCosReference{}),
+			infer.Function(&provider.GetGcsReference{}),
+			infer.Function(&provider.GetHTTPReference{}),
+			infer.Function(&provider.GetKubernetesReference{}),
 			infer.Function(&provider.GetLocalReference{}),
+			infer.Function(&provider.GetOssReference{}),
+			infer.Function(&provider.GetOutput{}),
+			infer.Function(&provider.GetPgReference{}),
 			infer.Function(&provider.GetRemoteReference{}),
 			infer.Function(&provider.GetS3Reference{}),
+			infer.Function(&provider.GetWorkspaceReferences{}),
+			infer.Function(&provider.ListWorkspaces{}),
 		},
+		Resources: []infer.InferredResource{
+			infer.Resource(&provider.Reference{}),
+		},
+		Config: infer.Config(&provider.Config{}),
 		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{
 			"state_reference": "state",
 		},
@@ -101,7 +118,6 @@ func NewProvider() p.Provider {
 		// Initialize the TF back-end exactly once during provider configuration
 		oldConfigure := pkg.Configure
 		pkg.Configure = func(ctx context.Context, req p.ConfigureRequest) error {
-			NewTerraformLogRedirector(ctx)
 			provider.InitTfBackend()
 			if oldConfigure != nil {
 				return oldConfigure(ctx, req)
@@ -110,5 +126,81 @@ func NewProvider() p.Provider {
 		}
 	}
 
+	{
+		// infer cannot encode secrets nested inside plain Go values, so functions
+		// and resources return sensitive Terraform values wrapped, and they are
+		// unwrapped into real secrets here.
+		oldInvoke := pkg.Invoke
+		pkg.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			resp, err := oldInvoke(ctx, req)
+			resp.Return = provider.UnwrapSecrets(resp.Return)
+			return resp, err
+		}
+		oldCreate := pkg.Create
+		pkg.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			resp, err := oldCreate(ctx, req)
+			resp.Properties = provider.UnwrapSecrets(resp.Properties)
+			return resp, err
+		}
+		oldUpdate := pkg.Update
+		pkg.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			resp, err := oldUpdate(ctx, req)
+			resp.Properties = provider.UnwrapSecrets(resp.Properties)
+			return resp, err
+		}
+		oldRead := pkg.Read
+		pkg.Read = func(ctx context.Context, req p.ReadRequest) (p.ReadResponse, error) {
+			resp, err := oldRead(ctx, req)
+			resp.Properties = provider.UnwrapSecrets(resp.Properties)
+			return resp, err
+		}
+	}
+
+	{
+		// infer has no check step for functions, so they fail with the failures of
+		// their arguments, which are reported as the failures of the invoke to point
+		// at the arguments.
+		oldInvoke := pkg.Invoke
+		pkg.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			resp, err := oldInvoke(ctx, req)
+			if failures := (provider.CheckFailures)(nil); errors.As(err, &failures) {
+				return p.InvokeResponse{Failures: failures}, nil
+			}
+			return resp, err
+		}
+	}
+
+	{
+		// Functions and resources read the configuration of the provider from the
+		// context of their call.
+		pkg.Invoke = withProviderConfig(pkg.Invoke)
+		pkg.Create = withProviderConfig(pkg.Create)
+		pkg.Update = withProviderConfig(pkg.Update)
+		pkg.Read = withProviderConfig(pkg.Read)
+		pkg.Diff = withProviderConfig(pkg.Diff)
+		pkg.Check = withProviderConfig(pkg.Check)
+	}
+
+	{
+		// Forward the log messages of Terraform's backends to the engine, through the
+		// logger of the call that reads state.
+		pkg.Invoke = withTerraformLogs(pkg.Invoke)
+		pkg.Create = withTerraformLogs(pkg.Create)
+		pkg.Update = withTerraformLogs(pkg.Update)
+		pkg.Read = withTerraformLogs(pkg.Read)
+		pkg.Diff = withTerraformLogs(pkg.Diff)
+		pkg.Check = withTerraformLogs(pkg.Check)
+	}
+
 	return pkg
 }
+
+// withProviderConfig wraps a provider method so it is called with the configuration
+// of the provider on its context.
+func withProviderConfig[Req, Resp any](
+	f func(context.Context, Req) (Resp, error),
+) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, req Req) (Resp, error) {
+		return f(provider.WithProviderConfig(ctx), req)
+	}
+}
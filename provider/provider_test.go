@@ -0,0 +1,89 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// TestInvokeCheckFailures checks that a function with conflicting arguments is
+// reported with a failure for each argument, rather than with an error.
+func TestInvokeCheckFailures(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]property.Value
+		token  string
+		args   map[string]property.Value
+		want   []p.CheckFailure
+	}{
+		{
+			name:  "conflicting arguments",
+			token: "terraform:state:getRemoteReference",
+			args: map[string]property.Value{
+				"organization": property.New("org"),
+				"workspaces": property.New(map[string]property.Value{
+					"name":   property.New("app"),
+					"prefix": property.New("app-"),
+				}),
+			},
+			want: []p.CheckFailure{
+				{Property: "workspaces.name", Reason: "conflicts with workspaces.prefix"},
+				{Property: "workspaces.prefix", Reason: "conflicts with workspaces.name"},
+			},
+		},
+		{
+			name: "argument conflicting with a default",
+			config: map[string]property.Value{
+				"azurermDefaults": property.New(map[string]property.Value{
+					"oidcRequestToken": property.New("request-token"),
+				}),
+			},
+			token: "terraform:state:getAzureRMReference",
+			args: map[string]property.Value{
+				"storageAccountName": property.New("account"),
+				"containerName":      property.New("tfstate"),
+				"key":                property.New("prod.tfstate"),
+				"oidcToken":          property.New("token"),
+			},
+			want: []p.CheckFailure{
+				{Property: "oidcToken", Reason: "conflicts with oidcRequestToken of the provider configuration"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The integration server of pulumi-go-provider does not build against the
+			// Pulumi SDK the provider uses, so the provider is called directly.
+			prov := NewProvider()
+			require.NoError(t, prov.Configure(t.Context(), p.ConfigureRequest{Args: property.NewMap(tt.config)}))
+
+			resp, err := prov.Invoke(t.Context(), p.InvokeRequest{
+				Token: tokens.Type(tt.token),
+				Args:  property.NewMap(tt.args),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.Failures)
+			assert.Empty(t, resp.Return.AsMap())
+		})
+	}
+}
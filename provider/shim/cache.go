@@ -0,0 +1,143 @@
+package shim
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StateCache remembers the states read through it, so reading the same workspace of
+// the same backend again is free until the entry expires. Concurrent reads of the
+// same state share a single read.
+//
+// The returned states are shared between callers and must not be modified.
+type StateCache struct {
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cachedState
+}
+
+type cachedState struct {
+	state   *State
+	expires time.Time
+}
+
+func NewStateCache() *StateCache {
+	return &StateCache{entries: map[string]cachedState{}}
+}
+
+// Read returns the state of workspaceName as StateReferenceRead does, from the cache
+// when an entry younger than ttl exists. Only successful reads are cached.
+//
+// A read shared by several callers is not bound to the context of any of them, so
+// one caller giving up does not fail the others. Each caller still returns as soon as
+// its own ctx is done. The shared read is bounded by timeout instead, unless it is 0,
+// so a read that hangs fails in time for a later call to start another.
+//
+// A shared read redacts its errors and log messages with the secrets of the caller
+// that started it, and forwards its messages to that caller only, so reads are only
+// shared between callers redacting the same secrets. Once the caller that started it
+// stops redirecting logs, its messages are written as RedirectLogs describes for reads
+// outliving their call.
+func (c *StateCache) Read(
+	ctx context.Context,
+	backendType string,
+	workspaceName string,
+	backendConfigValue map[string]cty.Value,
+	env Environment,
+	ttl time.Duration,
+	timeout time.Duration,
+) (*State, error) {
+	key, err := cacheKey(backendType, workspaceName, backendConfigValue, env)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && !time.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mu.Unlock()
+	if ok {
+		return entry.state, nil
+	}
+
+	read := c.group.DoChan(key+RedactorFrom(ctx).fingerprint(), func() (any, error) {
+		readCtx := context.WithoutCancel(ctx)
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			readCtx, cancel = context.WithTimeout(readCtx, timeout)
+			defer cancel()
+		}
+		state, err := StateReferenceRead(readCtx, backendType, workspaceName, backendConfigValue, env)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.sweep()
+		c.entries[key] = cachedState{state: state, expires: time.Now().Add(ttl)}
+		c.mu.Unlock()
+		return state, nil
+	})
+
+	select {
+	case r := <-read:
+		state, _ := r.Val.(*State)
+		return state, r.Err
+	case <-ctx.Done():
+		return nil, contextError(ctx, backendType, workspaceName, StageRefresh)
+	}
+}
+
+// sweep drops the expired entries, so states that are not read again do not stay in
+// memory. It is called holding c.mu.
+func (c *StateCache) sweep() {
+	now := time.Now()
+	maps.DeleteFunc(c.entries, func(_ string, entry cachedState) bool {
+		return !now.Before(entry.expires)
+	})
+}
+
+// cacheKey identifies a read of workspaceName from the backendType backend configured
+// with config within env. The configuration is hashed, since it commonly holds
+// credentials.
+func cacheKey(backendType, workspaceName string, config map[string]cty.Value, env Environment) (string, error) {
+	v := cty.ObjectVal(config)
+	ty, err := ctyjson.MarshalType(v.Type())
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "error hashing backend configuration: %s", err)
+	}
+	value, err := ctyjson.Marshal(v, v.Type())
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "error hashing backend configuration: %s", err)
+	}
+
+	parts := [][]byte{
+		[]byte(backendType), []byte(workspaceName), ty, value, []byte(strconv.FormatBool(env.DisableFallback)),
+	}
+	for _, k := range slices.Sorted(maps.Keys(env.Vars)) {
+		parts = append(parts, []byte(k), []byte(env.Vars[k]))
+	}
+
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
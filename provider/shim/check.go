@@ -0,0 +1,105 @@
+package shim
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	backendInit "github.com/hashicorp/terraform/internal/backend/init"
+	"github.com/hashicorp/terraform/internal/configs/configschema"
+	"github.com/hashicorp/terraform/internal/tfdiags"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ConfigFailure describes why the configuration of a backend is invalid.
+type ConfigFailure struct {
+	// Path is the attribute the failure is about, or empty when it is about the
+	// configuration as a whole.
+	Path   cty.Path
+	Reason string
+}
+
+// CheckConfig validates config as reading state does before configuring the backend,
+// against the backend's schema and with its PrepareConfig, without connecting to it.
+// It returns nothing when config is valid, or when backendType is not supported. When
+// ctx is done before the environment env scopes can be entered, it returns nothing
+// too, leaving the read to fail.
+func CheckConfig(
+	ctx context.Context, backendType string, config map[string]cty.Value, env Environment,
+) []ConfigFailure {
+	backendInitFn := backendInit.Backend(backendType)
+	if backendInitFn == nil {
+		return nil
+	}
+
+	// Backends built on the legacy SDK fall back to environment variables while
+	// preparing their configuration, so it is checked in the same environment it
+	// is read in.
+	_, leave, err := enterEnvironment(ctx, backendType, env)
+	if err != nil {
+		return nil
+	}
+	defer leave()
+
+	b := backendInitFn()
+	coerced, err := b.ConfigSchema().CoerceValue(cty.ObjectVal(config))
+	if err != nil {
+		var pathErr cty.PathError
+		if errors.As(err, &pathErr) {
+			return []ConfigFailure{{Path: pathErr.Path, Reason: pathErr.Error()}}
+		}
+		return []ConfigFailure{{Reason: err.Error()}}
+	}
+
+	_, diagnostics := b.PrepareConfig(coerced)
+	return withAttributePaths(b.ConfigSchema(), configFailures(diagnostics))
+}
+
+// CheckBackendConfig validates config as BackendConfig converts it for the backendType
+// backend, returning a failure for each argument it rejects. Like CheckConfig, it
+// returns nothing when backendType is not supported.
+func CheckBackendConfig(backendType string, config map[string]any) []ConfigFailure {
+	if backendInit.Backend(backendType) == nil {
+		return nil
+	}
+	_, diagnostics := backendConfig(backendType, config)
+	return configFailures(diagnostics)
+}
+
+// configFailures returns the failures the errors among diagnostics describe.
+func configFailures(diagnostics tfdiags.Diagnostics) []ConfigFailure {
+	var failures []ConfigFailure
+	for _, d := range diagnostics {
+		if d.Severity() != tfdiags.Error {
+			continue
+		}
+		desc := d.Description()
+		reason := desc.Summary
+		if desc.Detail != "" {
+			reason = desc.Detail
+		}
+		failures = append(failures, ConfigFailure{Path: tfdiags.GetAttribute(d), Reason: reason})
+	}
+	return failures
+}
+
+// leadingAttribute matches the attribute name a message starts with, as in "key must
+// not start with '/'" or "max_retries: must be positive".
+var leadingAttribute = regexp.MustCompile(`^"?([a-z0-9_]+)"?(?::| )`)
+
+// withAttributePaths fills in the path of the failures that lack one but name an
+// attribute of schema first. Backends built on the legacy SDK report their validation
+// errors that way, without a path.
+func withAttributePaths(schema *configschema.Block, failures []ConfigFailure) []ConfigFailure {
+	for i, f := range failures {
+		if len(f.Path) > 0 {
+			continue
+		}
+		if m := leadingAttribute.FindStringSubmatch(f.Reason); m != nil {
+			if _, ok := schema.Attributes[m[1]]; ok {
+				failures[i].Path = cty.GetAttrPath(m[1])
+			}
+		}
+	}
+	return failures
+}
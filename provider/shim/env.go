@@ -0,0 +1,197 @@
+package shim
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Environment scopes the environment variables a backend falls back to for settings
+// its configuration leaves unset, such as AWS_REGION for s3, to a single read.
+//
+// The environment is set for the whole read, since backends read it while they are
+// configured and again as they resolve credentials and read state. The environment is
+// shared by the whole process, so reads in the same environment run together, and
+// reads in different ones take turns.
+type Environment struct {
+	// Vars are set for the read, overriding the environment of the process.
+	Vars map[string]string
+	// DisableFallback hides the environment variables of the process the backend
+	// falls back to, leaving only Vars.
+	DisableFallback bool
+}
+
+// key identifies the environment of the process while a read of the backendType
+// backend with e runs. Reads with the same key may run together.
+func (e Environment) key(backendType string) string {
+	var b strings.Builder
+	if e.DisableFallback {
+		b.WriteString(backendType)
+	}
+	for _, k := range slices.Sorted(maps.Keys(e.Vars)) {
+		fmt.Fprintf(&b, "\x00%s=%s", k, e.Vars[k])
+	}
+	return b.String()
+}
+
+// fallbackEnvPrefixes lists the prefixes of the environment variables each backend,
+// or the SDK it is built on, falls back to.
+var fallbackEnvPrefixes = map[string][]string{
+	"azurerm":    {"ARM_", "ACTIONS_ID_TOKEN_REQUEST_"},
+	"cloud":      {"TF_CLOUD_", "TF_WORKSPACE", "TF_FORCE_LOCAL_BACKEND"},
+	"consul":     {"CONSUL_"},
+	"cos":        {"TENCENTCLOUD_", "TF_COS_"},
+	"gcs":        {"GOOGLE_"},
+	"http":       {"TF_HTTP_"},
+	"kubernetes": {"KUBE_"},
+	"oss":        {"ALICLOUD_", "OSS_"},
+	// The backend's own variables, and those lib/pq connects with.
+	"pg": {
+		"PG_CONN_STR", "PG_SCHEMA_NAME", "PG_SKIP_SCHEMA_CREATION", "PG_SKIP_TABLE_CREATION",
+		"PG_SKIP_INDEX_CREATION", "PGHOST", "PGHOSTADDR", "PGPORT", "PGDATABASE", "PGUSER", "PGPASSWORD",
+		"PGPASSFILE", "PGSERVICE", "PGSERVICEFILE", "PGOPTIONS", "PGAPPNAME", "PGSSLMODE", "PGSSLNEGOTIATION",
+		"PGSSLCERT", "PGSSLKEY", "PGSSLROOTCERT", "PGSSLSNI", "PGKRBSRVNAME", "PGCONNECT_TIMEOUT",
+		"PGCLIENTENCODING", "PGDATESTYLE", "PGTZ", "PGGEQO", "PGTARGETSESSIONATTRS", "PGLOADBALANCEHOSTS",
+		"PGMINPROTOCOLVERSION", "PGMAXPROTOCOLVERSION",
+	},
+	"remote": {"TF_FORCE_LOCAL_BACKEND"},
+	"s3":     {"AWS_"},
+}
+
+// environment holds the environment of the process as the reads running in it set
+// it. It is set by the first read to enter it and restored once the last read leaves.
+var environment struct {
+	sync.Mutex
+	// key is the key of the environment the running reads share.
+	key string
+	// reads counts the reads in the environment, and the steps they left running
+	// in the background.
+	reads int
+	// waiting counts the reads waiting for another environment to be left, which
+	// later reads in the current one queue behind, so they are not starved.
+	waiting int
+	restore func()
+	// left is closed once every read has left the environment.
+	left chan struct{}
+}
+
+type environmentKey struct{}
+
+// enterEnvironment waits for the environment of the process to be free or scoped as
+// env scopes it for the backendType backend, and sets it so. The returned context
+// records that reads under it run in the environment, until leave is called. It fails
+// at StageConfigure when ctx is done first.
+func enterEnvironment(
+	ctx context.Context, backendType string, env Environment,
+) (_ context.Context, leave func(), err error) {
+	key := env.key(backendType)
+	for {
+		environment.Lock()
+		if environment.reads == 0 || (environment.key == key && environment.waiting == 0) {
+			if environment.reads == 0 {
+				restore, err := env.apply(backendType)
+				if err != nil {
+					restore()
+					environment.Unlock()
+					return nil, nil, err
+				}
+				environment.key, environment.restore = key, restore
+				environment.left = make(chan struct{})
+			}
+			environment.reads++
+			environment.Unlock()
+			return context.WithValue(ctx, environmentKey{}, true), leaveEnvironment, nil
+		}
+		environment.waiting++
+		left := environment.left
+		environment.Unlock()
+
+		select {
+		case <-left:
+		case <-ctx.Done():
+		}
+		environment.Lock()
+		environment.waiting--
+		environment.Unlock()
+		if ctx.Err() != nil {
+			return nil, nil, contextError(ctx, backendType, "", StageConfigure)
+		}
+	}
+}
+
+// stayInEnvironment keeps the environment the reads under ctx entered, if any, set
+// until leave is called, for a step of the read that may outlive it.
+func stayInEnvironment(ctx context.Context) (leave func()) {
+	if ctx.Value(environmentKey{}) == nil {
+		return func() {}
+	}
+	environment.Lock()
+	defer environment.Unlock()
+	environment.reads++
+	return leaveEnvironment
+}
+
+// leaveEnvironment records that a read left the environment, restoring it when it
+// was the last.
+func leaveEnvironment() {
+	environment.Lock()
+	defer environment.Unlock()
+	environment.reads--
+	if environment.reads == 0 {
+		environment.restore()
+		close(environment.left)
+	}
+}
+
+// apply changes the environment of the process as e describes, returning a function
+// that restores it.
+func (e Environment) apply(backendType string) (restore func(), err error) {
+	saved := map[string]*string{}
+	set := func(k string, v *string) error {
+		if _, ok := saved[k]; !ok {
+			if old, ok := os.LookupEnv(k); ok {
+				saved[k] = &old
+			} else {
+				saved[k] = nil
+			}
+		}
+		if v == nil {
+			return os.Unsetenv(k)
+		}
+		return os.Setenv(k, *v)
+	}
+	restore = func() {
+		for k, v := range saved {
+			if v == nil {
+				_ = os.Unsetenv(k)
+			} else {
+				_ = os.Setenv(k, *v)
+			}
+		}
+	}
+
+	if e.DisableFallback {
+		prefixes := fallbackEnvPrefixes[backendType]
+		for _, kv := range os.Environ() {
+			k, _, _ := strings.Cut(kv, "=")
+			if slices.ContainsFunc(prefixes, func(p string) bool { return strings.HasPrefix(k, p) }) {
+				if err := set(k, nil); err != nil {
+					return restore, status.Errorf(codes.Internal, "error unsetting %s: %s", k, err)
+				}
+			}
+		}
+	}
+	for k, v := range e.Vars {
+		if err := set(k, &v); err != nil {
+			return restore, status.Errorf(codes.InvalidArgument, "invalid environment variable %q: %s", k, err)
+		}
+	}
+	return restore, nil
+}
@@ -0,0 +1,270 @@
+package shim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-tfe"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Stage is the step of reading state that failed.
+type Stage string
+
+const (
+	// StageArguments checks the arguments of the read that are not passed to the
+	// backend, such as its timeout.
+	StageArguments Stage = "arguments"
+	// StageCoerce checks the configuration against the backend's schema.
+	StageCoerce Stage = "coerce"
+	// StagePrepare validates the configuration, as the backend's PrepareConfig does.
+	StagePrepare Stage = "prepare"
+	// StageConfigure configures the backend, which commonly authenticates with it.
+	StageConfigure Stage = "configure"
+	// StageWorkspaces lists the workspaces of the backend.
+	StageWorkspaces Stage = "workspaces"
+	// StageStateMgr opens the state of a workspace.
+	StageStateMgr Stage = "statemgr"
+	// StageRefresh fetches the state of a workspace.
+	StageRefresh Stage = "refresh"
+	// StageOutputs selects the outputs of a state that was read.
+	StageOutputs Stage = "outputs"
+)
+
+var stageDescriptions = map[Stage]string{
+	StageArguments:  "checking the arguments",
+	StageCoerce:     "checking the configuration",
+	StagePrepare:    "validating the configuration",
+	StageConfigure:  "configuring the backend",
+	StageWorkspaces: "listing the workspaces",
+	StageStateMgr:   "opening the workspace",
+	StageRefresh:    "fetching the state",
+	StageOutputs:    "selecting the outputs",
+}
+
+// Reason classifies why reading state failed. Reasons are stable, so callers may
+// match on them.
+type Reason string
+
+const (
+	ReasonUnsupportedBackend Reason = "UNSUPPORTED_BACKEND"
+	ReasonInvalidArgument    Reason = "INVALID_ARGUMENT"
+	ReasonInvalidConfig      Reason = "INVALID_CONFIG"
+	ReasonUnauthenticated    Reason = "UNAUTHENTICATED"
+	ReasonAccessDenied       Reason = "ACCESS_DENIED"
+	ReasonBucketNotFound     Reason = "BUCKET_NOT_FOUND"
+	ReasonWorkspaceNotFound  Reason = "WORKSPACE_NOT_FOUND"
+	ReasonEmptyState         Reason = "EMPTY_STATE"
+	ReasonOutputNotFound     Reason = "OUTPUT_NOT_FOUND"
+	ReasonUnavailable        Reason = "BACKEND_UNAVAILABLE"
+	ReasonTimeout            Reason = "TIMEOUT"
+	ReasonCanceled           Reason = "CANCELED"
+	ReasonReadFailed         Reason = "READ_FAILED"
+)
+
+// reasons holds the status code and remediation hint of each Reason.
+var reasons = map[Reason]struct {
+	code codes.Code
+	hint string
+}{
+	ReasonUnsupportedBackend: {codes.InvalidArgument, "Use the name of a backend Terraform supports, as in the " +
+		"label of a backend block, e.g. s3."},
+	ReasonInvalidArgument: {codes.InvalidArgument, ""},
+	ReasonInvalidConfig:   {codes.InvalidArgument, "Check the arguments against the documentation of the backend."},
+	ReasonUnauthenticated: {codes.Unauthenticated, "Check the credentials of the backend. Arguments take " +
+		"precedence over the defaults of the provider configuration, which take precedence over environment " +
+		"variables."},
+	ReasonAccessDenied: {codes.PermissionDenied, "The credentials were accepted, but may not read the state. " +
+		"Grant them read access to the bucket or container and the state in it, e.g. s3:ListBucket and " +
+		"s3:GetObject for s3."},
+	ReasonBucketNotFound: {codes.NotFound, "Check the name of the bucket or container, and the region or " +
+		"account it is looked up in."},
+	ReasonWorkspaceNotFound: {codes.NotFound, "Check the name of the workspace. The listWorkspaces function " +
+		"returns the workspaces of a backend."},
+	ReasonEmptyState: {codes.NotFound, "No state exists at the configured location. Check the key, path or " +
+		"prefix of the state and the workspace, and that Terraform has applied the configuration."},
+	ReasonOutputNotFound: {codes.NotFound, "Check the names of the outputs. Only the outputs of the root " +
+		"module are kept in the state."},
+	ReasonUnavailable: {codes.Unavailable, "Check the address or endpoint of the backend, and that it is " +
+		"reachable from where the program runs."},
+	ReasonTimeout: {codes.DeadlineExceeded, "Raise the timeout of the function or of the provider " +
+		"configuration, or check that the backend is reachable from where the program runs."},
+	ReasonCanceled:   {codes.Canceled, ""},
+	ReasonReadFailed: {codes.Internal, ""},
+}
+
+// ReadError describes a failure reading state from a backend.
+type ReadError struct {
+	BackendType string
+	// Workspace is the workspace being read, or empty when the failure is not
+	// specific to a workspace, as when configuring the backend.
+	Workspace string
+	Stage     Stage
+	Reason    Reason
+	Err       error
+}
+
+func (e *ReadError) Error() string {
+	var msg strings.Builder
+	if e.Workspace != "" {
+		fmt.Fprintf(&msg, "error reading workspace %q of the %s backend", e.Workspace, e.BackendType)
+	} else {
+		fmt.Fprintf(&msg, "error reading the %s backend", e.BackendType)
+	}
+	fmt.Fprintf(&msg, " while %s (%s): %s", stageDescriptions[e.Stage], e.Reason, e.Err)
+	if hint := e.Hint(); hint != "" {
+		fmt.Fprintf(&msg, "\n%s", hint)
+	}
+	return msg.String()
+}
+
+func (e *ReadError) Unwrap() error { return e.Err }
+
+// Code returns the status code the error is reported with.
+func (e *ReadError) Code() codes.Code { return reasons[e.Reason].code }
+
+// Hint suggests how to resolve the error, when there is a common resolution.
+func (e *ReadError) Hint() string { return reasons[e.Reason].hint }
+
+// GRPCStatus reports the error with its code, and its reason and context as
+// [errdetails.ErrorInfo].
+func (e *ReadError) GRPCStatus() *status.Status {
+	st := status.New(e.Code(), e.Error())
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(e.Reason),
+		Domain: "terraform.pulumi.com",
+		Metadata: map[string]string{
+			"backendType": e.BackendType,
+			"workspace":   e.Workspace,
+			"stage":       string(e.Stage),
+		},
+	})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// readError returns a ReadError for err, classifying it by its message.
+func readError(backendType, workspace string, stage Stage, err error) *ReadError {
+	return &ReadError{
+		BackendType: backendType,
+		Workspace:   workspace,
+		Stage:       stage,
+		Reason:      classify(stage, err),
+		Err:         err,
+	}
+}
+
+// contextError is the error for a read of the backendType backend stopped at stage
+// because ctx is done.
+func contextError(ctx context.Context, backendType, workspace string, stage Stage) *ReadError {
+	reason := ReasonCanceled
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		reason = ReasonTimeout
+	}
+	return &ReadError{
+		BackendType: backendType,
+		Workspace:   workspace,
+		Stage:       stage,
+		Reason:      reason,
+		Err:         ctx.Err(),
+	}
+}
+
+// statusReasons holds the Reason of each HTTP status code a failure is classified by.
+var statusReasons = map[int]Reason{
+	http.StatusUnauthorized: ReasonUnauthenticated,
+	http.StatusForbidden:    ReasonAccessDenied,
+}
+
+// statusCode returns the HTTP status code of the response err failed with, when the
+// SDK the backend is built on exposes it and the backend kept the error.
+func statusCode(err error) (int, bool) {
+	// The AWS SDKs report the status code through RequestFailure in v1, and through
+	// ResponseError in v2.
+	var awsErr interface{ StatusCode() int }
+	if errors.As(err, &awsErr) {
+		return awsErr.StatusCode(), true
+	}
+	var awsV2Err interface{ HTTPStatusCode() int }
+	if errors.As(err, &awsV2Err) {
+		return awsV2Err.HTTPStatusCode(), true
+	}
+	var googleErr *googleapi.Error
+	if errors.As(err, &googleErr) {
+		return googleErr.Code, true
+	}
+	var azureErr autorest.DetailedError
+	if errors.As(err, &azureErr) {
+		code, ok := azureErr.StatusCode.(int)
+		return code, ok
+	}
+	if errors.Is(err, tfe.ErrUnauthorized) {
+		return http.StatusUnauthorized, true
+	}
+	return 0, false
+}
+
+// classifiers match the messages backends and the SDKs they are built on fail with,
+// in order of precedence. Most backends flatten the errors of their SDKs into
+// messages, so status codes are only matched where a message reports one.
+var classifiers = []struct {
+	reason  Reason
+	pattern *regexp.Regexp
+}{
+	{ReasonBucketNotFound, regexp.MustCompile(`(?i)NoSuchBucket|ContainerNotFound|` +
+		`(bucket|container) (does not|doesn't) exist`)},
+	{ReasonUnauthenticated, regexp.MustCompile(`(?i)InvalidAccessKeyId|SignatureDoesNotMatch|ExpiredToken|` +
+		`InvalidClientTokenId|no valid credential|could not find default credentials|invalid_grant|` +
+		`unauthori[sz]ed|requires auth|` + statusPattern("401"))},
+	{ReasonAccessDenied, regexp.MustCompile(`(?i)AccessDenied|access denied|forbidden|AuthorizationFailure|` +
+		`AuthorizationPermissionMismatch|permission denied|invalid auth|` + statusPattern("403"))},
+	{ReasonWorkspaceNotFound, regexp.MustCompile(`(?i)workspace\b.*(not found|(does not|doesn't) exist)`)},
+	{ReasonUnavailable, regexp.MustCompile(`(?i)connection refused|no such host|i/o timeout|` +
+		`connection reset|network is unreachable|TLS handshake timeout`)},
+}
+
+// statusPattern matches code reported as the status of a response, as in "status
+// code: 403" from the AWS SDK, "StatusCode=403" from the Azure SDK, "Error 403" from
+// the Google SDK, and "HTTP response code 403" from the http backend.
+func statusPattern(code string) string {
+	return `(status ?code|response code|HTTP error|googleapi: Error)[:=]? ?` + code + `\b`
+}
+
+// classify returns the Reason for err, failing at stage.
+func classify(stage Stage, err error) Reason {
+	if stage == StageCoerce || stage == StagePrepare {
+		return ReasonInvalidConfig
+	}
+	if code, ok := statusCode(err); ok {
+		if reason, ok := statusReasons[code]; ok {
+			return reason
+		}
+	}
+	msg := err.Error()
+	for _, c := range classifiers {
+		if c.pattern.MatchString(msg) {
+			return c.reason
+		}
+	}
+	if stage == StageConfigure {
+		return ReasonInvalidConfig
+	}
+	return ReasonReadFailed
+}
+
+// errEmptyState is the error of a ReadError for a workspace holding no state.
+var errEmptyState = errors.New("remote state not found")
+
+// errWorkspaceNotFound is the error of a ReadError for a workspace the backend does
+// not list.
+var errWorkspaceNotFound = errors.New("workspace does not exist")
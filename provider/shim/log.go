@@ -0,0 +1,205 @@
+package shim
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform/internal/logging"
+)
+
+// RedirectLogs forwards the log messages of Terraform and its backends emitted by
+// the calling goroutine, and by the reads made under the returned context, to w until
+// stop is called. Messages are forwarded at the level TF_LOG selects, or from WARN up
+// when it is unset.
+//
+// Terraform logs through a single global logger, with nothing tying a message to the
+// read that emitted it, so messages are told apart by the goroutine emitting them.
+// Messages emitted by goroutines the backends start of their own, or by reads still
+// running once their call stopped redirecting logs, belong to no call. They are
+// written to the output RedirectUnattributedLogs sets instead, redacted with the
+// secrets of every call they may belong to.
+func RedirectLogs(ctx context.Context, w io.Writer) (_ context.Context, stop func()) {
+	logRoutes.once.Do(func() {
+		if logger, ok := logging.HCLogger().(hclog.InterceptLogger); ok {
+			logger.RegisterSink(logDispatcher{})
+		}
+	})
+
+	route := &logRoute{
+		logger: hclog.New(&hclog.LoggerOptions{
+			Level:       hclog.Trace,
+			Output:      w,
+			DisableTime: true,
+		}),
+		redactor: RedactorFrom(ctx),
+	}
+	logRoutes.Lock()
+	logRoutes.open[route] = struct{}{}
+	logRoutes.Unlock()
+	leave := route.enter()
+	return context.WithValue(ctx, logRouteKey{}, route), func() {
+		leave()
+		logRoutes.Lock()
+		defer logRoutes.Unlock()
+		delete(logRoutes.open, route)
+		route.closed = true
+	}
+}
+
+// RedirectUnattributedLogs writes the log messages RedirectLogs forwards to no call
+// to w, instead of to the standard error of the process, until restore is called.
+func RedirectUnattributedLogs(w io.Writer) (restore func()) {
+	logRoutes.Lock()
+	defer logRoutes.Unlock()
+	saved := logRoutes.unattributed
+	logRoutes.unattributed = w
+	return func() {
+		logRoutes.Lock()
+		defer logRoutes.Unlock()
+		logRoutes.unattributed = saved
+	}
+}
+
+// logRoute is a call messages are redirected to, formatting them as Terraform does.
+type logRoute struct {
+	logger hclog.Logger
+	// redactor holds the secrets of the call, which its messages are redacted with
+	// once it no longer receives them.
+	redactor *Redactor
+	// closed is set, holding logRoutes, once the call stops redirecting logs.
+	closed bool
+}
+
+// enter attributes the messages of the calling goroutine to r until leave is called.
+// A nil r leaves them attributed as they were.
+func (r *logRoute) enter() (leave func()) {
+	if r == nil {
+		return func() {}
+	}
+	id := goroutineID()
+	logRoutes.Lock()
+	defer logRoutes.Unlock()
+	prev, ok := logRoutes.goroutines[id]
+	logRoutes.goroutines[id] = r
+	return func() {
+		logRoutes.Lock()
+		defer logRoutes.Unlock()
+		if ok {
+			logRoutes.goroutines[id] = prev
+		} else {
+			delete(logRoutes.goroutines, id)
+		}
+	}
+}
+
+type logRouteKey struct{}
+
+// logRouteFrom returns the route the reads under ctx forward their messages to, or
+// nil when logs are not redirected for them.
+func logRouteFrom(ctx context.Context) *logRoute {
+	r, _ := ctx.Value(logRouteKey{}).(*logRoute)
+	return r
+}
+
+// logRoutes holds the calls logs are redirected to, and the goroutines whose messages
+// belong to each.
+var logRoutes = struct {
+	sync.Mutex
+	once         sync.Once
+	open         map[*logRoute]struct{}
+	goroutines   map[uint64]*logRoute
+	unattributed io.Writer
+}{
+	open:         map[*logRoute]struct{}{},
+	goroutines:   map[uint64]*logRoute{},
+	unattributed: os.Stderr,
+}
+
+// goroutineID returns the ID of the calling goroutine, as the runtime prints it at
+// the top of its stack trace.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	b, _, _ = bytes.Cut(b, []byte(" "))
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
+
+// logDispatcher is the sink of Terraform's global logger, forwarding each message to
+// the call the goroutine emitting it belongs to.
+type logDispatcher struct{}
+
+func (logDispatcher) Accept(name string, level hclog.Level, msg string, args ...any) {
+	if level < logLevel() {
+		return
+	}
+
+	logRoutes.Lock()
+	route := logRoutes.goroutines[goroutineID()]
+	var redactors []*Redactor
+	if route == nil || route.closed {
+		if route != nil {
+			redactors = append(redactors, route.redactor)
+		} else {
+			for r := range logRoutes.open {
+				redactors = append(redactors, r.redactor)
+			}
+		}
+		route = nil
+	}
+	logRoutes.Unlock()
+
+	if route != nil {
+		logger := route.logger
+		if name != "" {
+			logger = logger.Named(name)
+		}
+		logger.Log(level, msg, args...)
+		return
+	}
+
+	// The message is formatted before it is written, so it can be redacted whole.
+	var buf bytes.Buffer
+	hclog.New(&hclog.LoggerOptions{
+		Name:        name,
+		Level:       hclog.Trace,
+		Output:      &buf,
+		DisableTime: true,
+	}).Log(level, msg, args...)
+	s := buf.String()
+	if len(redactors) == 0 {
+		s = (*Redactor)(nil).Redact(s)
+	}
+	for _, r := range redactors {
+		s = r.Redact(s)
+	}
+
+	logRoutes.Lock()
+	defer logRoutes.Unlock()
+	_, _ = io.WriteString(logRoutes.unattributed, s)
+}
+
+// logLevel returns the level of the messages RedirectLogs forwards.
+func logLevel() hclog.Level {
+	if os.Getenv("TF_LOG") == "" {
+		return hclog.Warn
+	}
+	return hclog.LevelFromString(logging.CurrentLogLevel())
+}
+
+// routeStandardLog sends the standard library's log output, which most backends log
+// through, to Terraform's logger, as Terraform itself does on start up. Packages
+// initialized after Terraform's logging, such as Pulumi's, redirect it elsewhere.
+func routeStandardLog() {
+	log.SetFlags(0)
+	log.SetPrefix("")
+	log.SetOutput(logging.HCLogger().StandardWriter(&hclog.StandardLoggerOptions{InferLevels: true}))
+}
@@ -0,0 +1,199 @@
+package shim
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/status"
+)
+
+// redacted replaces secrets in redacted messages, as in Pulumi's own logs.
+const redacted = "[secret]"
+
+// tokenPatterns match credentials that are redacted even when they were never added
+// to a Redactor, such as those backends pick up from the environment. Each is
+// replaced by its replacement, a template as in [regexp.Regexp.Expand].
+var tokenPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	// AWS access key IDs.
+	{regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`), redacted},
+	// The signature of Azure SAS tokens.
+	{regexp.MustCompile(`([?&]sig=)[^&\s"']+`), "${1}" + redacted},
+	// Terraform Cloud and Enterprise API tokens.
+	{regexp.MustCompile(`\b[0-9A-Za-z]{14}\.atlasv1\.[0-9A-Za-z_-]+`), redacted},
+	// Google OAuth access tokens.
+	{regexp.MustCompile(`\bya29\.[0-9A-Za-z_-]+`), redacted},
+	// JSON Web Tokens, as used by OIDC.
+	{regexp.MustCompile(`\beyJ[0-9A-Za-z_-]+\.[0-9A-Za-z_-]+\.[0-9A-Za-z_-]*`), redacted},
+	// Bearer tokens in Authorization headers.
+	{regexp.MustCompile(`(?i)(\bbearer\s+)[0-9A-Za-z._~+/-]+=*`), "${1}" + redacted},
+	// Passwords in URLs.
+	{regexp.MustCompile(`(://[^/\s:@]+:)[^/\s@]+@`), "${1}" + redacted + "@"},
+}
+
+// Redactor scrubs the secrets of a single call from messages. The zero Redactor, and
+// a nil one, only scrub common credential formats.
+type Redactor struct {
+	mu       sync.RWMutex
+	values   map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// Add records values to redact from every message r scrubs from now on. Values
+// shorter than 3 characters are ignored, so they do not garble messages.
+func (r *Redactor) Add(values ...string) {
+	var added []string
+	for _, v := range values {
+		added = append(added, secretForms(v)...)
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.values == nil {
+		r.values = map[string]struct{}{}
+	}
+	changed := false
+	for _, v := range added {
+		if _, ok := r.values[v]; !ok {
+			r.values[v] = struct{}{}
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	// The replacer tries the values in order, so longer values go first to redact
+	// the whole of a secret that starts with another.
+	sorted := slices.SortedFunc(maps.Keys(r.values), func(a, b string) int {
+		return cmp.Or(len(b)-len(a), strings.Compare(a, b))
+	})
+	oldnew := make([]string, 0, 2*len(sorted))
+	for _, v := range sorted {
+		oldnew = append(oldnew, v, redacted)
+	}
+	r.replacer = strings.NewReplacer(oldnew...)
+}
+
+// fingerprint identifies the secrets added to r, without holding them. Redactors
+// with the same secrets have the same fingerprint, and those without any, or nil ones,
+// have none.
+func (r *Redactor) fingerprint() string {
+	if r == nil {
+		return ""
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.values) == 0 {
+		return ""
+	}
+	h := sha256.New()
+	for _, v := range slices.Sorted(maps.Keys(r.values)) {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// secretForms returns the forms of value a message may hold it in: as is, escaped as
+// in JSON, and line by line, since log messages are forwarded a line at a time.
+func secretForms(value string) []string {
+	var forms []string
+	add := func(s string) {
+		if len(s) >= 3 {
+			forms = append(forms, s)
+		}
+	}
+
+	add(value)
+	if b, err := json.Marshal(value); err == nil {
+		if escaped := string(b[1 : len(b)-1]); escaped != value {
+			add(escaped)
+		}
+	}
+	if strings.Contains(value, "\n") {
+		for line := range strings.Lines(value) {
+			add(strings.TrimSpace(line))
+		}
+	}
+	return forms
+}
+
+// Redact scrubs the secrets added to r and common credential formats from s.
+func (r *Redactor) Redact(s string) string {
+	if r != nil {
+		r.mu.RLock()
+		replacer := r.replacer
+		r.mu.RUnlock()
+		if replacer != nil {
+			s = replacer.Replace(s)
+		}
+	}
+
+	for _, t := range tokenPatterns {
+		s = t.pattern.ReplaceAllString(s, t.replacement)
+	}
+	return s
+}
+
+type redactorKey struct{}
+
+// WithRedactor returns a copy of ctx carrying r, which reads under it redact their
+// errors with.
+func WithRedactor(ctx context.Context, r *Redactor) context.Context {
+	return context.WithValue(ctx, redactorKey{}, r)
+}
+
+// RedactorFrom returns the Redactor ctx carries, or nil when it carries none.
+func RedactorFrom(ctx context.Context) *Redactor {
+	r, _ := ctx.Value(redactorKey{}).(*Redactor)
+	return r
+}
+
+// redactedError is an error whose message had secrets scrubbed from it. It still
+// unwraps to the original error, so callers can match it with errors.Is and errors.As.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string { return e.msg }
+
+func (e *redactedError) Unwrap() error { return e.err }
+
+// GRPCStatus reports the redacted message with the status code of the original error.
+func (e *redactedError) GRPCStatus() *status.Status {
+	return status.New(status.Code(e.err), e.msg)
+}
+
+// redactError scrubs the secrets r redacts from the message of err, keeping its
+// status code, and the context of a ReadError.
+func redactError(r *Redactor, err error) error {
+	if err == nil {
+		return nil
+	}
+	if re := (*ReadError)(nil); errors.As(err, &re) {
+		redacted := *re
+		redacted.Err = &redactedError{msg: r.Redact(re.Err.Error()), err: re.Err}
+		return &redacted
+	}
+	msg := err.Error()
+	if st, ok := status.FromError(err); ok {
+		msg = st.Message()
+	}
+	return &redactedError{msg: r.Redact(msg), err: err}
+}
@@ -4,17 +4,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math/big"
+	"os"
+	"slices"
+	"sync"
 
-	"github.com/hashicorp/terraform-svchost/disco"
+	"github.com/hashicorp/terraform/internal/addrs"
 	"github.com/hashicorp/terraform/internal/backend"
 	backendInit "github.com/hashicorp/terraform/internal/backend/init"
+	backendLocal "github.com/hashicorp/terraform/internal/backend/local"
+	"github.com/hashicorp/terraform/internal/states"
+	"github.com/hashicorp/terraform/internal/states/remote"
+	"github.com/hashicorp/terraform/internal/states/statemgr"
+	"github.com/hashicorp/terraform/internal/tfdiags"
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
-func InitTfBackend() { backendInit.Init(disco.New()) }
+func InitTfBackend() {
+	backendInit.Init(services)
+	routeStandardLog()
+}
 
 // VersionConflictIgnorer is implemented by backends that can skip the check
 // requiring the local Terraform version to match the remote workspace's version.
@@ -27,77 +38,606 @@ func BackendFactory(backendType string) func() backend.Backend {
 	return backendInit.Backend(backendType)
 }
 
-func StateReferenceRead(
-	ctx context.Context,
-	backendType string,
-	workspaceName string,
-	backendConfigValue map[string]cty.Value,
-) (map[string]any, error) {
+// BackendConfig converts free-form configuration, as decoded from Pulumi inputs,
+// into cty values of the types the backend's ConfigSchema expects. Keys the backend
+// does not know about and values that do not convert are reported as diagnostics.
+func BackendConfig(backendType string, config map[string]any) (map[string]cty.Value, error) {
+	if backendInit.Backend(backendType) == nil {
+		return nil, unsupportedBackend(backendType)
+	}
+	values, diagnostics := backendConfig(backendType, config)
+	if diagnostics.HasErrors() {
+		return nil, readError(backendType, "", StageCoerce, diagnostics.Err())
+	}
+	return values, nil
+}
+
+// backendConfig converts config as BackendConfig does, reporting each argument the
+// supported backendType backend rejects as a diagnostic.
+func backendConfig(backendType string, config map[string]any) (map[string]cty.Value, tfdiags.Diagnostics) {
+	configType := backendInit.Backend(backendType)().ConfigSchema().ImpliedType()
+
+	var diagnostics tfdiags.Diagnostics
+	values := make(map[string]cty.Value, len(config))
+	for _, k := range slices.Sorted(maps.Keys(config)) {
+		if !configType.HasAttribute(k) {
+			diagnostics = diagnostics.Append(tfdiags.AttributeValue(tfdiags.Error, "Unsupported argument",
+				fmt.Sprintf("An argument named %q is not expected by the %s backend.", k, backendType),
+				cty.GetAttrPath(k)))
+			continue
+		}
+
+		// Pulumi values are plain JSON values, so cty's JSON decoder does the
+		// conversion to the attribute's type for us.
+		jsonBytes, err := json.Marshal(config[k])
+		if err != nil {
+			diagnostics = diagnostics.Append(tfdiags.AttributeValue(tfdiags.Error, "Invalid value",
+				fmt.Sprintf("Error marshaling %q to JSON: %s.", k, err), cty.GetAttrPath(k)))
+			continue
+		}
+		v, err := ctyjson.Unmarshal(jsonBytes, configType.AttributeType(k))
+		if err != nil {
+			diagnostics = diagnostics.Append(tfdiags.AttributeValue(tfdiags.Error, "Invalid value",
+				fmt.Sprintf("Inappropriate value for argument %q: %s.", k, err), cty.GetAttrPath(k)))
+			continue
+		}
+		values[k] = v
+	}
+	return values, diagnostics
+}
+
+// unsupportedBackend is the error for a backendType Terraform does not support.
+func unsupportedBackend(backendType string) error {
+	return &ReadError{
+		BackendType: backendType,
+		Stage:       StageCoerce,
+		Reason:      ReasonUnsupportedBackend,
+		Err:         fmt.Errorf("unsupported backend type %q", backendType),
+	}
+}
+
+// State is the part of a Terraform state read by StateReferenceRead.
+type State struct {
+	// Outputs holds the root module outputs, converted to plain Go values.
+	Outputs map[string]any
+	// SensitiveOutputs holds the names of the outputs marked sensitive in Terraform.
+	SensitiveOutputs map[string]bool
+	// Resources holds the current object of every resource instance, across all
+	// modules, ordered by address.
+	Resources []Resource
+
+	// Serial and Lineage identify the snapshot that was read. Of two snapshots with
+	// the same lineage, the one with the higher serial is newer.
+	Serial  uint64
+	Lineage string
+	// TerraformVersion is the version of Terraform that wrote the snapshot, when known.
+	TerraformVersion string
+	// StateFormatVersion is the version of the state file format the snapshot is
+	// stored in, or nil when the backend does not expose the raw snapshot.
+	StateFormatVersion *int
+}
+
+// Resource is a single resource instance recorded in a Terraform state.
+type Resource struct {
+	// Address is the absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+	Address string
+	// Module is the address of the module instance holding the resource, empty for
+	// the root module.
+	Module string
+	// Mode is managed for resources and data for data sources.
+	Mode string
+	Type string
+	Name string
+	// Provider is the provider configuration that last managed the resource, e.g.
+	// provider["registry.terraform.io/hashicorp/aws"].
+	Provider string
+	// IndexKey is the int count index or string for_each key of the instance, or nil
+	// when the resource uses neither.
+	IndexKey any
+	// Attributes holds the attributes of the instance, converted to plain Go values.
+	Attributes map[string]any
+	// SensitiveAttributes holds the names of the top-level attributes that contain a
+	// value marked sensitive in Terraform.
+	SensitiveAttributes map[string]bool
+}
+
+// configureBackend returns the backendType backend, configured with config.
+func configureBackend(backendType string, config map[string]cty.Value) (backend.Backend, error) {
 	// Ensure the backendType is known about by Terraform
 	backendInitFn := backendInit.Backend(backendType)
 	if backendInitFn == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "unsupported backend type %q", backendType)
+		return nil, unsupportedBackend(backendType)
 	}
 
 	// Get the configuration schema from the backend
-	backend := backendInitFn()
+	b := backendInitFn()
 
 	// Attempt to coerce our config object into the config schema types - note errors
-	backendConfigCoerced, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(backendConfigValue))
+	backendConfigCoerced, err := b.ConfigSchema().CoerceValue(cty.ObjectVal(config))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error coercing config from Pulumi format to cty: %s", err)
+		return nil, readError(backendType, "", StageCoerce, err)
 	}
 
 	// Attempt to prepare the backend with configuration, returning any diagnostics to the engine
-	preparedBackendConfig, diagnostics := backend.PrepareConfig(backendConfigCoerced)
+	preparedBackendConfig, diagnostics := b.PrepareConfig(backendConfigCoerced)
 	if diagnostics.HasErrors() {
-		return nil, status.Errorf(codes.Internal, "error preparing config: %s", diagnostics.Err())
+		return nil, readError(backendType, "", StagePrepare, diagnostics.Err())
 	}
 
 	// Actually prepare the backend with the valid configuration
-	diagnostics = backend.Configure(preparedBackendConfig)
+	diagnostics = b.Configure(preparedBackendConfig)
 	if diagnostics.HasErrors() {
-		return nil, status.Errorf(codes.InvalidArgument, "error in backend configuration: %s",
-			diagnostics.ErrWithWarnings())
+		return nil, readError(backendType, "", StageConfigure, diagnostics.ErrWithWarnings())
 	}
 
 	// Since we only read state, skip the version conflict check that requires
 	// the local Terraform version to match the remote workspace's version.
 	// Fixes https://github.com/pulumi/pulumi-terraform/issues/627
-	if b, ok := backend.(VersionConflictIgnorer); ok {
-		b.IgnoreVersionConflict()
+	if ignorer, ok := b.(VersionConflictIgnorer); ok {
+		ignorer.IgnoreVersionConflict()
+	}
+
+	return b, nil
+}
+
+// Workspaces lists the workspaces of the backendType backend configured with config,
+// sorted by name.
+func Workspaces(
+	ctx context.Context, backendType string, config map[string]cty.Value, env Environment,
+) ([]string, error) {
+	ctx, leave, err := enterEnvironment(ctx, backendType, env)
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	backend, err := withContext(ctx, backendType, "", StageConfigure, func() (backend.Backend, error) {
+		return configureBackend(backendType, config)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return withContext(ctx, backendType, "", StageWorkspaces, func() ([]string, error) {
+		workspaces, err := backend.Workspaces()
+		if err != nil {
+			return nil, readError(backendType, "", StageWorkspaces, err)
+		}
+		slices.Sort(workspaces)
+		return workspaces, nil
+	})
+}
+
+func StateReferenceRead(
+	ctx context.Context,
+	backendType string,
+	workspaceName string,
+	backendConfigValue map[string]cty.Value,
+	env Environment,
+) (*State, error) {
+	ctx, leave, err := enterEnvironment(ctx, backendType, env)
+	if err != nil {
+		return nil, err
+	}
+	defer leave()
+
+	backend, err := withContext(ctx, backendType, "", StageConfigure, func() (backend.Backend, error) {
+		return configureBackend(backendType, backendConfigValue)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := newWorkspaceSet(backendType, backend, backendConfigValue)
+	return withContext(ctx, backendType, workspaceName, StageRefresh, func() (*State, error) {
+		return readState(backendType, backend, workspaceName, workspaces, new(sync.Mutex))
+	})
+}
+
+// ReadWorkspacesOptions selects the workspaces StateReferenceReadWorkspaces reads.
+type ReadWorkspacesOptions struct {
+	// Workspaces lists the workspaces to read. When nil, every workspace of the
+	// backend accepted by Include is read.
+	Workspaces []string
+	// Include, when set, filters the workspaces listed by the backend.
+	Include func(workspace string) bool
+	// Parallelism is the most workspaces read at the same time.
+	Parallelism int
+}
+
+// StateReferenceReadWorkspaces reads the state of several workspaces of a backend,
+// configuring the backend only once. States are keyed by workspace, and the error
+// reading a workspace is returned in errs instead of failing the other reads. When
+// ctx is done before every workspace has been read, the whole read fails.
+func StateReferenceReadWorkspaces(
+	ctx context.Context,
+	backendType string,
+	backendConfigValue map[string]cty.Value,
+	env Environment,
+	opts ReadWorkspacesOptions,
+) (states map[string]*State, errs map[string]error, err error) {
+	ctx, leave, err := enterEnvironment(ctx, backendType, env)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer leave()
+
+	// Each step is bounded by ctx on its own, so the read returns once ctx is done even
+	// when reads are left running.
+	backend, err := withContext(ctx, backendType, "", StageConfigure, func() (backend.Backend, error) {
+		return configureBackend(backendType, backendConfigValue)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The workspaces are listed once, both to select them and to check that each
+	// workspace read exists.
+	existing := newWorkspaceSet(backendType, backend, backendConfigValue)
+	workspaces := opts.Workspaces
+	if workspaces == nil {
+		all, err := withContext(ctx, backendType, "", StageWorkspaces, func() ([]string, error) {
+			all, err := existing.list()
+			if err != nil {
+				return nil, readError(backendType, "", StageWorkspaces, err)
+			}
+			return all, nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, w := range all {
+			if opts.Include == nil || opts.Include(w) {
+				workspaces = append(workspaces, w)
+			}
+		}
+	}
+
+	states = make(map[string]*State, len(workspaces))
+	errs = map[string]error{}
+	var mu, stateMgrMu sync.Mutex
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+	for range min(max(opts.Parallelism, 1), len(workspaces)) {
+		wg.Go(func() {
+			for w := range jobs {
+				state, err := withContext(ctx, backendType, w, StageRefresh, func() (*State, error) {
+					return readState(backendType, backend, w, existing, &stateMgrMu)
+				})
+
+				mu.Lock()
+				if err != nil {
+					errs[w] = err
+				} else {
+					states[w] = state
+				}
+				mu.Unlock()
+			}
+		})
+	}
+	for _, w := range workspaces {
+		jobs <- w
+	}
+	close(jobs)
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, nil, contextError(ctx, backendType, "", StageRefresh)
+	}
+	return states, errs, nil
+}
+
+// withContext runs read, the step of reading the backendType backend at stage,
+// returning early when ctx is done first. Terraform's backends do not take a
+// context, so an abandoned read is left to finish in the background, in the
+// environment the read entered. The log messages
+// read emits go to the call whose logs ctx redirects, for as long as it redirects
+// them. Secrets are redacted from the error read returns, since backends commonly echo
+// their configuration in diagnostics.
+func withContext[T any](
+	ctx context.Context, backendType, workspace string, stage Stage, read func() (T, error),
+) (T, error) {
+	var zero T
+	if ctx.Err() != nil {
+		return zero, contextError(ctx, backendType, workspace, stage)
+	}
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	route := logRouteFrom(ctx)
+	leave := stayInEnvironment(ctx)
+	go func() {
+		defer leave()
+		defer route.enter()()
+		value, err := read()
+		done <- result{value, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, redactError(RedactorFrom(ctx), r.err)
+	case <-ctx.Done():
+		return zero, contextError(ctx, backendType, workspace, stage)
+	}
+}
+
+// stateMgr returns the state manager of workspaceName, holding stateMgrMu while the
+// local backend builds it.
+func stateMgr(b backend.Backend, workspaceName string, stateMgrMu *sync.Mutex) (statemgr.Full, error) {
+	if _, ok := b.(*backendLocal.Local); ok {
+		stateMgrMu.Lock()
+		defer stateMgrMu.Unlock()
+	}
+	return b.StateMgr(workspaceOrDefault(workspaceName))
+}
+
+// readState reads the state of workspaceName from a configured backend, once
+// workspaces finds it exists.
+//
+// The local backend caches the state managers it builds in a plain map, so it builds
+// them holding stateMgrMu. Other backends build them independently, often with a
+// network round-trip, so they are not serialized. Reading through the state managers
+// is independent.
+func readState(
+	backendType string,
+	backend backend.Backend,
+	workspaceName string,
+	workspaces *workspaceSet,
+	stateMgrMu *sync.Mutex,
+) (*State, error) {
+	if err := workspaces.check(workspaceName); err != nil {
+		return nil, err
 	}
 
 	// Get the state manager from the backend for the appropriate workspace
-	stateManager, err := backend.StateMgr(workspaceName)
+	stateManager, err := stateMgr(backend, workspaceName, stateMgrMu)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "error constructing backend state manager: %s", err)
+		return nil, readError(backendType, workspaceName, StageStateMgr, err)
+	}
+
+	// Terraform upgrades snapshots to the current format as it reads them, dropping
+	// the header fields of the original, so keep the raw snapshot where we can.
+	var rawSnapshot func() []byte
+	switch sm := stateManager.(type) {
+	case *remote.State:
+		client := &snapshotClient{Client: sm.Client}
+		sm.Client = client
+		rawSnapshot = func() []byte { return client.data }
+	case *statemgr.Filesystem:
+		if b, ok := backend.(*backendLocal.Local); ok {
+			statePath, _, _ := b.StatePaths(workspaceName)
+			rawSnapshot = func() []byte {
+				data, _ := os.ReadFile(statePath)
+				return data
+			}
+		}
 	}
 
 	// Refresh the state
 	if err := stateManager.RefreshState(); err != nil {
-		return nil, status.Errorf(codes.NotFound, "error refreshing Terraform state: %s", err)
+		return nil, readError(backendType, workspaceName, StageRefresh, err)
 	}
 
 	// Check the state isn't empty
 	state := stateManager.State()
 	if state == nil {
-		return nil, status.Error(codes.NotFound, "remote state not found")
+		return nil, &ReadError{
+			BackendType: backendType,
+			Workspace:   workspaceName,
+			Stage:       StageRefresh,
+			Reason:      ReasonEmptyState,
+			Err:         errEmptyState,
+		}
 	}
 
 	// Convert back into the type that we expect.
 
 	outputs := map[string]any{}
+	sensitive := map[string]bool{}
 	for k, v := range state.RootModule().OutputValues {
-		jsonBytes, err := ctyjson.Marshal(v.Value, v.Value.Type())
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling cty to JSON: %w", err)
+		outputs[k] = goValue(v.Value)
+		if v.Sensitive {
+			sensitive[k] = true
+		}
+	}
+
+	resources, err := stateResources(state)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &State{Outputs: outputs, SensitiveOutputs: sensitive, Resources: resources}
+	if m, ok := stateManager.(statemgr.PersistentMeta); ok {
+		meta := m.StateSnapshotMeta()
+		result.Serial = meta.Serial
+		result.Lineage = meta.Lineage
+		if meta.TerraformVersion != nil {
+			result.TerraformVersion = meta.TerraformVersion.String()
+		}
+	}
+	if rawSnapshot != nil {
+		var header struct {
+			Version          *int   `json:"version"`
+			TerraformVersion string `json:"terraform_version"`
+		}
+		// Snapshots in the pre-JSON version 0 format have no header we can read.
+		if err := json.Unmarshal(rawSnapshot(), &header); err == nil {
+			result.StateFormatVersion = header.Version
+			if header.TerraformVersion != "" {
+				result.TerraformVersion = header.TerraformVersion
+			}
+		}
+	}
+	return result, nil
+}
+
+// snapshotClient records the last snapshot read through a remote state client.
+type snapshotClient struct {
+	remote.Client
+	data []byte
+}
+
+func (c *snapshotClient) Get() (*remote.Payload, error) {
+	payload, err := c.Client.Get()
+	if payload != nil {
+		c.data = payload.Data
+	}
+	return payload, err
+}
+
+// stateResources lists the current object of every resource instance in state.
+// Deposed objects are left out, since they are about to be destroyed.
+func stateResources(state *states.State) ([]Resource, error) {
+	var instances []addrs.AbsResourceInstance
+	for _, ms := range state.Modules {
+		for _, rs := range ms.Resources {
+			for key, is := range rs.Instances {
+				if is.Current != nil {
+					instances = append(instances, rs.Addr.Instance(key))
+				}
+			}
+		}
+	}
+	slices.SortFunc(instances, func(a, b addrs.AbsResourceInstance) int {
+		switch {
+		case a.Less(b):
+			return -1
+		case b.Less(a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	resources := make([]Resource, 0, len(instances))
+	for _, addr := range instances {
+		rs := state.Resource(addr.ContainingResource())
+		obj := state.ResourceInstance(addr).Current
+
+		var attributes map[string]any
+		switch {
+		case obj.AttrsJSON != nil:
+			// Without the provider's schema, the attribute types are implied by
+			// the JSON itself.
+			ty, err := ctyjson.ImpliedType(obj.AttrsJSON)
+			if err != nil {
+				return nil, fmt.Errorf("error reading attributes of %s: %w", addr, err)
+			}
+			v, err := ctyjson.Unmarshal(obj.AttrsJSON, ty)
+			if err != nil {
+				return nil, fmt.Errorf("error reading attributes of %s: %w", addr, err)
+			}
+			attributes, _ = goValue(v).(map[string]any)
+		case obj.AttrsFlat != nil:
+			// Objects written by Terraform 0.11 and earlier keep their attributes in
+			// the legacy flatmap format until their provider upgrades them.
+			attributes = make(map[string]any, len(obj.AttrsFlat))
+			for k, v := range obj.AttrsFlat {
+				attributes[k] = v
+			}
+		}
+
+		sensitive := map[string]bool{}
+		for _, pvm := range obj.AttrSensitivePaths {
+			if len(pvm.Path) == 0 {
+				continue
+			}
+			if step, ok := pvm.Path[0].(cty.GetAttrStep); ok {
+				sensitive[step.Name] = true
+			}
+		}
+
+		resources = append(resources, Resource{
+			Address:             addr.String(),
+			Module:              addr.Module.String(),
+			Mode:                resourceMode(addr.Resource.Resource.Mode),
+			Type:                addr.Resource.Resource.Type,
+			Name:                addr.Resource.Resource.Name,
+			Provider:            rs.ProviderConfig.String(),
+			IndexKey:            instanceKeyValue(addr.Resource.Key),
+			Attributes:          attributes,
+			SensitiveAttributes: sensitive,
+		})
+	}
+	return resources, nil
+}
+
+// resourceMode names mode the way terraform show -json does.
+func resourceMode(mode addrs.ResourceMode) string {
+	switch mode {
+	case addrs.ManagedResourceMode:
+		return "managed"
+	case addrs.DataResourceMode:
+		return "data"
+	default:
+		return ""
+	}
+}
+
+func instanceKeyValue(key addrs.InstanceKey) any {
+	switch key := key.(type) {
+	case addrs.IntKey:
+		return int(key)
+	case addrs.StringKey:
+		return string(key)
+	default:
+		return nil
+	}
+}
+
+// maxSafeInteger is the largest integer n such that n and n+1 are both exactly
+// representable as a float64, the only number type Pulumi has.
+const maxSafeInteger = 1<<53 - 1
+
+// goValue converts v into the plain Go value Pulumi encodes it as.
+//
+// Integers come back as int. Pulumi carries numbers as float64, so integers outside
+// ±maxSafeInteger come back as their exact decimal string instead of being rounded.
+// An attribute holding such an integer is then a string in one state and a number
+// in another, which the schema documents on every property holding these values.
+// Other numbers come back as float64. Lists, sets and tuples come back as []any,
+// and objects and maps as map[string]any. Marks are dropped.
+func goValue(v cty.Value) any {
+	v, _ = v.Unmark()
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	ty := v.Type()
+	switch {
+	case ty == cty.String:
+		return v.AsString()
+	case ty == cty.Bool:
+		return v.True()
+	case ty == cty.Number:
+		bf := v.AsBigFloat()
+		if bf.IsInt() {
+			if i, acc := bf.Int64(); acc == big.Exact && i >= -maxSafeInteger && i <= maxSafeInteger {
+				return int(i)
+			}
+			return bf.Text('f', -1)
+		}
+		f, _ := bf.Float64()
+		return f
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		elems := make([]any, 0, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			_, e := it.Element()
+			elems = append(elems, goValue(e))
 		}
-		var goV any
-		if err := json.Unmarshal(jsonBytes, &goV); err != nil {
-			return nil, fmt.Errorf("error unmarshaling JSON: %w", err)
+		return elems
+	case ty.IsMapType() || ty.IsObjectType():
+		m := make(map[string]any, v.LengthInt())
+		for it := v.ElementIterator(); it.Next(); {
+			k, e := it.Element()
+			m[k.AsString()] = goValue(e)
 		}
-		outputs[k] = goV
+		return m
+	default:
+		// Capsule types never appear in state.
+		return nil
 	}
-	return outputs, nil
 }
@@ -0,0 +1,204 @@
+package shim
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/terraform-svchost/disco"
+	"github.com/hashicorp/terraform/internal/backend"
+	"github.com/hashicorp/terraform/internal/backend/remote-state/kubernetes"
+	"github.com/hashicorp/terraform/internal/cloud"
+	"github.com/zclconf/go-cty/cty"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// services discovers the services of the hosts the cloud and remote backends
+// connect to, caching what it finds. The backends and their probes share it.
+var services = disco.New()
+
+// workspaceSet tells which workspaces of a configured backend exist, listing them at
+// most once.
+//
+// Backends create the workspaces they are asked to open and do not list, taking a
+// lock and writing an empty state, which a read must never do. So a workspace must be
+// listed, and found by the probe of its backend in workspaceProbes, before it is read.
+type workspaceSet struct {
+	backendType string
+	backend     backend.Backend
+	config      map[string]cty.Value
+	list        func() ([]string, error)
+}
+
+func newWorkspaceSet(backendType string, b backend.Backend, config map[string]cty.Value) *workspaceSet {
+	return &workspaceSet{
+		backendType: backendType,
+		backend:     b,
+		config:      config,
+		list:        sync.OnceValues(b.Workspaces),
+	}
+}
+
+// check fails when workspaceName does not exist, so that it is not opened. Backends
+// list the default workspace even when it holds no state, so it is reported as
+// holding none when it is missing. Backends that cannot list their workspaces, such
+// as http, do not create them either, unless they have a probe.
+func (s *workspaceSet) check(workspaceName string) error {
+	name := workspaceOrDefault(workspaceName)
+	listed, err := s.list()
+	switch {
+	case errors.Is(err, backend.ErrWorkspacesNotSupported):
+	case err != nil:
+		return readError(s.backendType, workspaceName, StageWorkspaces, err)
+	case !slices.Contains(listed, name):
+		return missingWorkspace(s.backendType, workspaceName)
+	}
+
+	if probe, ok := workspaceProbes[s.backendType]; ok {
+		exists, err := probe(s.backend, s.config, name)
+		if err != nil {
+			return readError(s.backendType, workspaceName, StageWorkspaces, err)
+		}
+		if !exists {
+			return missingWorkspace(s.backendType, workspaceName)
+		}
+	}
+	return nil
+}
+
+// missingWorkspace is the error for reading workspaceName, which does not exist.
+func missingWorkspace(backendType, workspaceName string) *ReadError {
+	readErr := &ReadError{
+		BackendType: backendType,
+		Workspace:   workspaceName,
+		Stage:       StageWorkspaces,
+		Reason:      ReasonWorkspaceNotFound,
+		Err:         errWorkspaceNotFound,
+	}
+	if workspaceOrDefault(workspaceName) == backend.DefaultStateName {
+		readErr.Reason, readErr.Err = ReasonEmptyState, errEmptyState
+	}
+	return readErr
+}
+
+// workspaceOrDefault returns workspaceName, or the name of the default workspace when
+// it is empty, as it is for backends without workspaces, such as local.
+func workspaceOrDefault(workspaceName string) string {
+	if workspaceName == "" {
+		return backend.DefaultStateName
+	}
+	return workspaceName
+}
+
+// workspaceProbe reports whether workspaceName exists in b, the backend configured
+// with config.
+type workspaceProbe func(b backend.Backend, config map[string]cty.Value, workspaceName string) (bool, error)
+
+// workspaceProbes check that a workspace a backend lists, or a backend that cannot
+// list its workspaces is asked to open, exists. They are needed for backends that
+// list workspaces without checking they exist, yet create them when opened.
+var workspaceProbes = map[string]workspaceProbe{
+	"cloud":      tfeWorkspaceExists,
+	"kubernetes": kubernetesWorkspaceExists,
+	"remote":     tfeWorkspaceExists,
+}
+
+// kubernetesWorkspaceExists reports whether the secret holding workspaceName exists.
+// The kubernetes backend lists the default workspace even when it has no secret, and
+// opening it then writes one.
+func kubernetesWorkspaceExists(b backend.Backend, _ map[string]cty.Value, workspaceName string) (bool, error) {
+	k, ok := b.(*kubernetes.Backend)
+	if !ok || workspaceName != backend.DefaultStateName {
+		return true, nil
+	}
+
+	client, err := k.KubernetesSecretClient()
+	if err != nil {
+		return false, err
+	}
+	// Secrets are named as the backend names them.
+	name := strings.Join([]string{"tfstate", workspaceName, k.Config().Get("secret_suffix").(string)}, "-")
+	_, err = client.Get(context.Background(), name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// tfeWorkspaceExists reports whether the workspace of HCP Terraform or Terraform
+// Enterprise that workspaceName selects exists. The cloud backend lists the workspace
+// named by workspaces.name without looking it up, and the remote backend cannot list
+// it at all, yet both create it when it is opened. Workspaces selected by tags or a
+// prefix are listed from the API, so they need no probe.
+func tfeWorkspaceExists(b backend.Backend, config map[string]cty.Value, workspaceName string) (bool, error) {
+	hosts, ok := b.(interface {
+		ServiceDiscoveryAliases() ([]backend.HostAlias, error)
+	})
+	if !ok {
+		return true, nil
+	}
+
+	// The organization is resolved as the backends resolve it. They only fall back
+	// to the credentials of service discovery for the token, and there are none.
+	var name, organization string
+	switch b := b.(type) {
+	case *cloud.Cloud:
+		if b.WorkspaceMapping.Strategy() != cloud.WorkspaceNameStrategy {
+			return true, nil
+		}
+		name = b.WorkspaceMapping.Name
+		organization = cmp.Or(configString(config, "organization"), os.Getenv("TF_CLOUD_ORGANIZATION"))
+	default:
+		// The remote backend reads workspaces.name in place of the default workspace.
+		name = configString(config, "workspaces", "name")
+		if name == "" || workspaceName != backend.DefaultStateName {
+			return true, nil
+		}
+		organization = configString(config, "organization")
+	}
+
+	aliases, err := hosts.ServiceDiscoveryAliases()
+	if err != nil || len(aliases) == 0 {
+		return false, err
+	}
+	service, err := services.DiscoverServiceURL(aliases[0].To, "tfe.v2")
+	if err != nil {
+		return false, err
+	}
+	client, err := tfe.NewClient(&tfe.Config{
+		Address:  service.String(),
+		BasePath: service.Path,
+		Token:    configString(config, "token"),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Workspaces.Read(context.Background(), organization, name)
+	if errors.Is(err, tfe.ErrResourceNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// configString returns the string config holds at the attribute path, or "" when it
+// holds none.
+func configString(config map[string]cty.Value, path ...string) string {
+	v, ok := config[path[0]]
+	for _, name := range path[1:] {
+		if !ok || v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(name) {
+			return ""
+		}
+		v = v.GetAttr(name)
+	}
+	if !ok || v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return ""
+	}
+	return v.AsString()
+}
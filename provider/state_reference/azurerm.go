@@ -17,9 +17,9 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform/shim"
 	"github.com/zclconf/go-cty/cty"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -70,6 +70,9 @@ type GetAzureRMReferenceArgs struct {
 	OidcRequestToken  *string `pulumi:"oidcRequestToken,optional" provider:"secret"`
 
 	UseAzureadAuth *bool `pulumi:"useAzureadAuth,optional"`
+
+	OutputArgs
+	ReadArgs
 }
 
 func (r *GetAzureRMReferenceArgs) Annotate(a infer.Annotator) {
@@ -130,6 +133,87 @@ func (r *GetAzureRMReferenceArgs) Annotate(a infer.Annotator) {
 	a.SetDefault(&r.Workspace, defaultWorkspace)
 }
 
+// AzureRMDefaults holds provider-wide fallbacks for the environment and credential
+// arguments of getAzureRMReference. They are also used by the functions taking a
+// backendType of azurerm.
+type AzureRMDefaults struct {
+	Environment  *string `pulumi:"environment,optional"`
+	MetadataHost *string `pulumi:"metadataHost,optional"`
+	Endpoint     *string `pulumi:"endpoint,optional"`
+
+	SubscriptionID *string `pulumi:"subscriptionId,optional"`
+	TenantID       *string `pulumi:"tenantId,optional"`
+	ClientID       *string `pulumi:"clientId,optional"`
+
+	ClientSecret              *string `pulumi:"clientSecret,optional" provider:"secret"`
+	ClientCertificatePath     *string `pulumi:"clientCertificatePath,optional"`
+	ClientCertificatePassword *string `pulumi:"clientCertificatePassword,optional" provider:"secret"`
+
+	UseMsi      *bool   `pulumi:"useMsi,optional"`
+	MsiEndpoint *string `pulumi:"msiEndpoint,optional"`
+
+	UseOidc           *bool   `pulumi:"useOidc,optional"`
+	OidcToken         *string `pulumi:"oidcToken,optional" provider:"secret"`
+	OidcTokenFilePath *string `pulumi:"oidcTokenFilePath,optional"`
+	OidcRequestURL    *string `pulumi:"oidcRequestUrl,optional"`
+	OidcRequestToken  *string `pulumi:"oidcRequestToken,optional" provider:"secret"`
+
+	UseAzureadAuth *bool `pulumi:"useAzureadAuth,optional"`
+}
+
+func (r *AzureRMDefaults) Annotate(a infer.Annotator) {
+	a.Describe(&r.Environment, "The default Azure cloud environment.")
+	a.Describe(&r.MetadataHost, "The default hostname of the Azure metadata service.")
+	a.Describe(&r.Endpoint, "The default custom endpoint for the Azure Resource Manager API.")
+
+	a.Describe(&r.SubscriptionID, "The default subscription ID holding the storage account.")
+	a.Describe(&r.TenantID, "The default tenant ID to authenticate against.")
+	a.Describe(&r.ClientID, "The default client ID to authenticate as.")
+
+	a.Describe(&r.ClientSecret, "The default client secret used for service principal authentication.")
+	a.Describe(&r.ClientCertificatePath, "The default path to the PFX file used as the client certificate.")
+	a.Describe(&r.ClientCertificatePassword, "The password of the default client certificate.")
+
+	a.Describe(&r.UseMsi, "Whether to authenticate using Managed Service Identity by default.")
+	a.Describe(&r.MsiEndpoint, "The default endpoint of the Managed Service Identity.")
+
+	a.Describe(&r.UseOidc, "Whether to authenticate using OIDC by default.")
+	a.Describe(&r.OidcToken, "The default JWT token for OIDC authentication.")
+	a.Describe(&r.OidcTokenFilePath, "The default path to a file containing a JWT token for OIDC authentication.")
+	a.Describe(&r.OidcRequestURL, "The default URL of the OIDC provider to request an ID token from.")
+	a.Describe(&r.OidcRequestToken, "The default bearer token for requests to oidcRequestUrl.")
+
+	a.Describe(&r.UseAzureadAuth, "Whether to authenticate against the storage container with AzureAD by "+
+		"default.")
+}
+
+// backendConfig builds the azurerm backend configuration the defaults provide, keyed
+// by the backend's attribute names.
+func (r *AzureRMDefaults) backendConfig() map[string]cty.Value {
+	if r == nil {
+		return nil
+	}
+	return map[string]cty.Value{
+		"environment":                 ctyStringOrNil(r.Environment),
+		"metadata_host":               ctyStringOrNil(r.MetadataHost),
+		"endpoint":                    ctyStringOrNil(r.Endpoint),
+		"subscription_id":             ctyStringOrNil(r.SubscriptionID),
+		"tenant_id":                   ctyStringOrNil(r.TenantID),
+		"client_id":                   ctyStringOrNil(r.ClientID),
+		"client_secret":               ctyStringOrNil(r.ClientSecret),
+		"client_certificate_path":     ctyStringOrNil(r.ClientCertificatePath),
+		"client_certificate_password": ctyStringOrNil(r.ClientCertificatePassword),
+		"use_msi":                     ctyBoolOrNil(r.UseMsi),
+		"msi_endpoint":                ctyStringOrNil(r.MsiEndpoint),
+		"use_oidc":                    ctyBoolOrNil(r.UseOidc),
+		"oidc_token":                  ctyStringOrNil(r.OidcToken),
+		"oidc_token_file_path":        ctyStringOrNil(r.OidcTokenFilePath),
+		"oidc_request_url":            ctyStringOrNil(r.OidcRequestURL),
+		"oidc_request_token":          ctyStringOrNil(r.OidcRequestToken),
+		"use_azuread_auth":            ctyBoolOrNil(r.UseAzureadAuth),
+	}
+}
+
 // WireDependencies lets us tell users that our outputs shouldn't be secret, even when
 // the credentials (when provided) are always secret.
 //
@@ -172,12 +256,20 @@ func (r *GetAzureRMReferenceArgs) backendConfig() map[string]cty.Value {
 	}
 }
 
+// check validates the arguments before reading.
+func (r *GetAzureRMReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "azurerm", r.backendConfig(), r.Env, argumentPath)
+}
+
 func (r *GetAzureRMReference) Invoke(
 	ctx context.Context, req infer.FunctionRequest[GetAzureRMReferenceArgs],
 ) (infer.FunctionResponse[StateReferenceOutputs], error) {
 	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
 
-	results, err := shim.StateReferenceRead(ctx, "azurerm", *args.Workspace, args.backendConfig())
-
-	return infer.FunctionResponse[StateReferenceOutputs]{Output: StateReferenceOutputs{results}}, err
+	return readStateReference(ctx, "azurerm", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
 }
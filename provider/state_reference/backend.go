@@ -0,0 +1,166 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetBackendReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetBackendReference)(nil))
+	_ = (infer.ExplicitDependencies[GetBackendReferenceArgs, StateReferenceOutputs])((*GetBackendReference)(nil))
+)
+
+func (r *GetBackendReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state from any backend Terraform supports, configured with the same arguments "+
+		"as the backend block in Terraform. Prefer the typed functions, such as getS3Reference, when one "+
+		"exists for the backend.")
+}
+
+// BackendArgs are the arguments configuring the backend read by the functions that take
+// a backendType.
+type BackendArgs struct {
+	BackendType  string         `pulumi:"backendType"`
+	Config       map[string]any `pulumi:"config,optional"`
+	SecretConfig map[string]any `pulumi:"secretConfig,optional" provider:"secret"`
+}
+
+func (r *BackendArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.BackendType, "The type of the backend, as in the label of the backend block, e.g. s3.")
+	a.Describe(&r.Config, "The backend configuration, keyed by the backend's argument names, e.g. "+
+		"{bucket = \"my-state\"} for s3.")
+	a.Describe(&r.SecretConfig, "Backend configuration holding credentials. It is merged with config, and "+
+		"a key may not be set in both.")
+}
+
+// WorkspaceArgs are the arguments selecting the workspace read by the functions that
+// read a single workspace.
+type WorkspaceArgs struct {
+	Workspace *string `pulumi:"workspace,optional"`
+}
+
+func (r *WorkspaceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+type GetBackendReferenceArgs struct {
+	BackendArgs
+	WorkspaceArgs
+
+	OutputArgs
+	ReadArgs
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the secretConfig (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetBackendReference) WireDependencies(
+	f infer.FieldSelector, _ *GetBackendReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// merge merges config and secretConfig and converts the result into the configuration
+// of the backend.
+func (r *BackendArgs) merge() (map[string]cty.Value, error) {
+	merged := maps.Clone(r.Config)
+	if merged == nil {
+		merged = make(map[string]any, len(r.SecretConfig))
+	}
+	for k, v := range r.SecretConfig {
+		if _, ok := merged[k]; ok {
+			return nil, argumentError(r.BackendType, fmt.Errorf("%q is set in both config and secretConfig", k))
+		}
+		merged[k] = v
+	}
+	return shim.BackendConfig(r.BackendType, merged)
+}
+
+// check validates the backend configuration as the backend does before reading, with
+// the environment variables env set as the read sets them.
+func (r *BackendArgs) check(ctx context.Context, env map[string]string) []p.CheckFailure {
+	if shim.BackendFactory(r.BackendType) == nil {
+		return []p.CheckFailure{{
+			Property: "backendType",
+			Reason:   fmt.Sprintf("unsupported backend type %q", r.BackendType),
+		}}
+	}
+
+	var failures []p.CheckFailure
+	for _, k := range slices.Sorted(maps.Keys(r.SecretConfig)) {
+		if _, ok := r.Config[k]; ok {
+			failures = append(failures, p.CheckFailure{
+				Property: "secretConfig." + k,
+				Reason:   fmt.Sprintf("%q is also set in config", k),
+			})
+		}
+	}
+	if len(failures) > 0 {
+		return failures
+	}
+
+	merged := make(map[string]any, len(r.Config)+len(r.SecretConfig))
+	maps.Copy(merged, r.Config)
+	maps.Copy(merged, r.SecretConfig)
+	propertyName := configPath(r.SecretConfig)
+	failures = checkFailures(ctx, shim.CheckBackendConfig(r.BackendType, merged), propertyName)
+	if len(failures) > 0 {
+		return failures
+	}
+	values, err := shim.BackendConfig(r.BackendType, merged)
+	if err != nil {
+		return []p.CheckFailure{{Property: "config", Reason: err.Error()}}
+	}
+	return checkBackend(ctx, r.BackendType, values, env, propertyName)
+}
+
+// check validates the backend configuration as the backend does before reading.
+func (r *GetBackendReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return r.BackendArgs.check(ctx, r.Env)
+}
+
+func (r *GetBackendReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetBackendReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	config, err := args.merge()
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, args.BackendType, *args.Workspace, config,
+		args.readOptions(args.OutputArgs))
+}
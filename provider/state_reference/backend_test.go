@@ -0,0 +1,146 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+func TestStateReferenceReadBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    GetBackendReferenceArgs
+		wantErr string
+	}{
+		{
+			name: "config",
+			args: GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType: "local",
+					Config:      map[string]any{localPathAttribute: "testdata/test.tfstate"},
+				},
+			},
+		},
+		{
+			name: "secret config",
+			args: GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType:  "local",
+					SecretConfig: map[string]any{localPathAttribute: "testdata/test.tfstate"},
+				},
+			},
+		},
+		{
+			name: "unsupported backend type",
+			args: GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{BackendType: "nonexistent"},
+			},
+			wantErr: `unsupported backend type "nonexistent"`,
+		},
+		{
+			name: "unknown key",
+			args: GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType: "local",
+					Config: map[string]any{
+						localPathAttribute: "testdata/test.tfstate",
+						"bucket":           "my-state",
+					},
+				},
+			},
+			wantErr: `config.bucket: An argument named "bucket" is not expected by the local backend.`,
+		},
+		{
+			name: "key in config and secretConfig",
+			args: GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType:  "local",
+					Config:       map[string]any{localPathAttribute: "testdata/test.tfstate"},
+					SecretConfig: map[string]any{localPathAttribute: "testdata/test.tfstate"},
+				},
+			},
+			wantErr: `secretConfig.path: "path" is also set in config`,
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Workspace = ptr(defaultWorkspace)
+
+			resp, err := (&GetBackendReference{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetBackendReferenceArgs]{Input: tt.args})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+}
+
+// TestBackendConfig checks that free-form values convert to the types of the
+// backend's schema, nested blocks included.
+func TestBackendConfig(t *testing.T) {
+	InitTfBackend()
+
+	t.Run("converts values", func(t *testing.T) {
+		config, err := shim.BackendConfig("kubernetes", map[string]any{
+			"secret_suffix": "network",
+			"config_paths":  []any{"/a", "/b"},
+			"insecure":      true,
+			"labels":        map[string]any{"team": "infra"},
+			"exec": []any{map[string]any{
+				"api_version": "client.authentication.k8s.io/v1beta1",
+				"command":     "aws",
+			}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, cty.StringVal("network"), config["secret_suffix"])
+		assert.Equal(t, cty.ListVal([]cty.Value{cty.StringVal("/a"), cty.StringVal("/b")}), config["config_paths"])
+		assert.Equal(t, cty.True, config["insecure"])
+		assert.Equal(t, cty.MapVal(map[string]cty.Value{"team": cty.StringVal("infra")}), config["labels"])
+		assert.Equal(t, cty.StringVal("aws"), config["exec"].Index(cty.NumberIntVal(0)).GetAttr("command"))
+		assert.True(t, config["exec"].Index(cty.NumberIntVal(0)).GetAttr("args").IsNull())
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		config, err := shim.BackendConfig("http", map[string]any{"retry_max": float64(5)})
+		require.NoError(t, err)
+		assert.True(t, config["retry_max"].RawEquals(cty.NumberIntVal(5)))
+	})
+
+	t.Run("reports every invalid value", func(t *testing.T) {
+		_, err := shim.BackendConfig("http", map[string]any{
+			"retry_max":  "many",
+			"not_an_arg": true,
+		})
+		require.ErrorContains(t, err, `An argument named "not_an_arg" is not expected by the http backend.`)
+		require.ErrorContains(t, err, `Inappropriate value for argument "retry_max"`)
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// CheckFailures is the error of a function whose arguments failed their checks. The
+// provider reports it as the failures of the invoke, so each is shown against the
+// argument it is about.
+type CheckFailures []p.CheckFailure
+
+func (f CheckFailures) Error() string {
+	reasons := make([]string, len(f))
+	for i, failure := range f {
+		if failure.Property == "" {
+			reasons[i] = failure.Reason
+		} else {
+			reasons[i] = fmt.Sprintf("%s: %s", failure.Property, failure.Reason)
+		}
+	}
+	return "invalid arguments: " + strings.Join(reasons, "; ")
+}
+
+// checker is implemented by the arguments of every function and resource reading
+// state. check validates them before anything is read, with the backend's
+// PrepareConfig and the rules the backend leaves to its documentation, returning a
+// failure for each invalid argument.
+type checker interface {
+	check(ctx context.Context) []p.CheckFailure
+}
+
+// prepare starts a call with args: it records their secrets for redaction under the
+// returned context, and checks them.
+func prepare(ctx context.Context, args checker) (context.Context, error) {
+	ctx = withSecrets(ctx, args)
+	return ctx, checkArgs(ctx, args)
+}
+
+// checkArgs checks args, returning their failures as CheckFailures.
+func checkArgs(ctx context.Context, args checker) error {
+	if failures := args.check(ctx); len(failures) > 0 {
+		return CheckFailures(failures)
+	}
+	return nil
+}
+
+// argumentError is the error for an argument of a read of the backendType backend
+// that is invalid in a way its checks do not report.
+func argumentError(backendType string, err error) error {
+	return &shim.ReadError{
+		BackendType: backendType,
+		Stage:       shim.StageArguments,
+		Reason:      shim.ReasonInvalidArgument,
+		Err:         err,
+	}
+}
+
+// checkBackend checks config as the backendType backend reads it, with the defaults of
+// the provider applied and in the environment the read runs in. propertyName names the
+// argument the attribute at a path is set from.
+func checkBackend(
+	ctx context.Context, backendType string, config map[string]cty.Value, env map[string]string,
+	propertyName func(cty.Path) string,
+) []p.CheckFailure {
+	cfg := providerConfig(ctx)
+	merged := cfg.withBackendDefaults(backendType, config)
+	if failures := backendConflicts(backendType, config, merged, propertyName); len(failures) > 0 {
+		return failures
+	}
+	return checkFailures(ctx, shim.CheckConfig(ctx, backendType, merged, cfg.environment(env)), propertyName)
+}
+
+// exclusiveAttributes holds, for each backend, the attributes it documents as
+// exclusive with others but resolves silently, or only rejects once it connects.
+var exclusiveAttributes = map[string][]struct {
+	attribute cty.Path
+	others    []cty.Path
+}{
+	"azurerm": {{
+		attribute: cty.GetAttrPath("oidc_request_token"),
+		others:    []cty.Path{cty.GetAttrPath("oidc_token"), cty.GetAttrPath("oidc_token_file_path")},
+	}},
+	// stateMgrName would otherwise silently prefer workspaces.name.
+	"remote": {{
+		attribute: cty.GetAttrPath("workspaces").GetAttr("name"),
+		others:    []cty.Path{cty.GetAttrPath("workspaces").GetAttr("prefix")},
+	}},
+	"cloud": {{
+		attribute: cty.GetAttrPath("workspaces").GetAttr("name"),
+		others:    []cty.Path{cty.GetAttrPath("workspaces").GetAttr("tags")},
+	}},
+}
+
+// backendConflicts returns the failures of the exclusive attributes of the backendType
+// backend set together in merged, which is config with the defaults of the provider
+// applied. The arguments are checked as they are read, so an argument conflicting with
+// a default fails too.
+func backendConflicts(
+	backendType string, config, merged map[string]cty.Value, propertyName func(cty.Path) string,
+) []p.CheckFailure {
+	arg := func(path cty.Path) argument {
+		return argument{
+			name:      propertyName(path),
+			set:       attributeSet(merged, path),
+			byDefault: !attributeSet(config, path),
+		}
+	}
+	var failures []p.CheckFailure
+	for _, exclusive := range exclusiveAttributes[backendType] {
+		others := make([]argument, len(exclusive.others))
+		for i, other := range exclusive.others {
+			others[i] = arg(other)
+		}
+		failures = append(failures, conflicts(arg(exclusive.attribute), others...)...)
+	}
+	return failures
+}
+
+// attributeSet reports whether the attribute at path is set in config, to a value
+// other than an empty collection.
+func attributeSet(config map[string]cty.Value, path cty.Path) bool {
+	if config == nil {
+		return false
+	}
+	v, err := path.Apply(cty.ObjectVal(config))
+	if err != nil || v.IsNull() || !v.IsKnown() {
+		return false
+	}
+	return !v.CanIterateElements() || v.LengthInt() > 0
+}
+
+// checkFailures converts the failures of a backend configuration into check failures,
+// naming the argument each is about with propertyName. Reasons may quote the invalid
+// value, so they are redacted as errors are.
+func checkFailures(
+	ctx context.Context, failures []shim.ConfigFailure, propertyName func(cty.Path) string,
+) []p.CheckFailure {
+	var checkFailures []p.CheckFailure
+	for _, f := range failures {
+		checkFailures = append(checkFailures, p.CheckFailure{
+			Property: propertyName(f.Path),
+			Reason:   RedactSecrets(ctx, f.Reason),
+		})
+	}
+	return checkFailures
+}
+
+// argumentPath names the argument of a typed function the attribute at path is set
+// from. Their arguments are the attributes of the backend in camelCase, e.g.
+// useAzureadAuth for use_azuread_auth.
+func argumentPath(path cty.Path) string { return propertyPath(path, camelCase) }
+
+// configPath returns a function naming the entry of config or secretConfig the
+// attribute at a path is set from.
+func configPath(secretConfig map[string]any) func(cty.Path) string {
+	return func(path cty.Path) string {
+		root := "config"
+		if len(path) > 0 {
+			if attr, ok := path[0].(cty.GetAttrStep); ok {
+				if _, secret := secretConfig[attr.Name]; secret {
+					root = "secretConfig"
+				}
+			}
+		}
+		if name := propertyPath(path, func(attribute string) string { return attribute }); name != "" {
+			return root + "." + name
+		}
+		return root
+	}
+}
+
+// propertyPath renders path as a Pulumi property path, e.g. workspaces.name or
+// tags[0], with each attribute named by name.
+func propertyPath(path cty.Path, name func(attribute string) string) string {
+	var b strings.Builder
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(name(step.Name))
+		case cty.IndexStep:
+			switch {
+			case !step.Key.IsKnown() || step.Key.IsNull():
+				// Elements of sets are keyed by their value, which is no use here.
+			case step.Key.Type() == cty.Number:
+				i, _ := step.Key.AsBigFloat().Int64()
+				fmt.Fprintf(&b, "[%d]", i)
+			case step.Key.Type() == cty.String:
+				fmt.Fprintf(&b, "[%q]", step.Key.AsString())
+			}
+		}
+	}
+	return b.String()
+}
+
+// camelCase converts a snake_case attribute name to camelCase.
+func camelCase(attribute string) string {
+	words := strings.Split(attribute, "_")
+	for i := 1; i < len(words); i++ {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// argument is an argument a conflict applies to, whether it is set, and whether it
+// is set by the defaults of the provider rather than by the call.
+type argument struct {
+	name      string
+	set       bool
+	byDefault bool
+}
+
+// String names the argument in the reason of a failure.
+func (a argument) String() string {
+	if a.byDefault {
+		return a.name + " of the provider configuration"
+	}
+	return a.name
+}
+
+// conflicts returns a failure for arg and for each of others set along with it, for
+// arguments that are exclusive but would be resolved silently, or only rejected once
+// the backend connects. An argument set by the defaults of the provider only fails
+// when the other is also set by them, so the failures point at the arguments of the
+// call.
+func conflicts(arg argument, others ...argument) []p.CheckFailure {
+	if !arg.set {
+		return nil
+	}
+	var failures []p.CheckFailure
+	for _, other := range others {
+		if !other.set {
+			continue
+		}
+		if !arg.byDefault || other.byDefault {
+			failures = append(failures,
+				p.CheckFailure{Property: arg.name, Reason: fmt.Sprintf("conflicts with %s", other)})
+		}
+		if !other.byDefault || arg.byDefault {
+			failures = append(failures,
+				p.CheckFailure{Property: other.name, Reason: fmt.Sprintf("conflicts with %s", arg)})
+		}
+	}
+	return failures
+}
@@ -0,0 +1,267 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCheckArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   checker
+		config Config
+		want   []p.CheckFailure
+	}{
+		{
+			name: "remote name and prefix",
+			args: &GetRemoteReferenceArgs{
+				Organization: "org",
+				Workspaces:   Workspaces{Name: ptr("app"), Prefix: ptr("app-")},
+			},
+			want: []p.CheckFailure{
+				{Property: "workspaces.name", Reason: "conflicts with workspaces.prefix"},
+				{Property: "workspaces.prefix", Reason: "conflicts with workspaces.name"},
+			},
+		},
+		{
+			name: "remote prepare config",
+			args: &GetRemoteReferenceArgs{Workspaces: Workspaces{Name: ptr("app")}},
+			want: []p.CheckFailure{
+				{Property: "organization", Reason: `The "organization" attribute value must not be empty.`},
+			},
+		},
+		{
+			name: "cloud name and tags",
+			args: &GetCloudReferenceArgs{
+				Organization: ptr("org"),
+				Workspaces:   CloudWorkspaces{Name: ptr("app"), Tags: []string{"networking"}},
+			},
+			want: []p.CheckFailure{
+				{Property: "workspaces.name", Reason: "conflicts with workspaces.tags"},
+				{Property: "workspaces.tags", Reason: "conflicts with workspaces.name"},
+			},
+		},
+		{
+			name: "cloud tags without workspace",
+			args: &GetCloudReferenceArgs{
+				Organization: ptr("org"),
+				Workspaces:   CloudWorkspaces{Tags: []string{"networking"}},
+			},
+			want: []p.CheckFailure{{
+				Property: "workspace",
+				Reason:   "must be set to choose which of the workspaces with workspaces.tags to read",
+			}},
+		},
+		{
+			name: "cloud tags and workspace",
+			args: &GetCloudReferenceArgs{
+				Organization: ptr("org"),
+				Workspaces:   CloudWorkspaces{Tags: []string{"networking"}},
+				Workspace:    ptr("networking-prod"),
+			},
+		},
+		{
+			name: "azurerm oidc token and request token",
+			args: &GetAzureRMReferenceArgs{
+				StorageAccountName: "account",
+				ContainerName:      "tfstate",
+				Key:                "prod.tfstate",
+				OidcToken:          ptr("token"),
+				OidcRequestToken:   ptr("request-token"),
+			},
+			want: []p.CheckFailure{
+				{Property: "oidcRequestToken", Reason: "conflicts with oidcToken"},
+				{Property: "oidcToken", Reason: "conflicts with oidcRequestToken"},
+			},
+		},
+		{
+			name: "azurerm oidc token and default request token",
+			args: &GetAzureRMReferenceArgs{
+				StorageAccountName: "account",
+				ContainerName:      "tfstate",
+				Key:                "prod.tfstate",
+				OidcToken:          ptr("token"),
+			},
+			config: Config{AzureRMDefaults: &AzureRMDefaults{OidcRequestToken: ptr("request-token")}},
+			want: []p.CheckFailure{
+				{Property: "oidcToken", Reason: "conflicts with oidcRequestToken of the provider configuration"},
+			},
+		},
+		{
+			name: "remote name and prefix in config",
+			args: &GetBackendReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType: "remote",
+					Config: map[string]any{
+						"organization": "org",
+						"workspaces":   map[string]any{"name": "app", "prefix": "app-"},
+					},
+				},
+			},
+			want: []p.CheckFailure{
+				{Property: "config.workspaces.name", Reason: "conflicts with config.workspaces.prefix"},
+				{Property: "config.workspaces.prefix", Reason: "conflicts with config.workspaces.name"},
+			},
+		},
+		{
+			name: "legacy backend validation",
+			args: &GetS3ReferenceArgs{Bucket: "state", Key: "/prod.tfstate", Region: ptr("us-west-2")},
+			want: []p.CheckFailure{{Property: "key", Reason: "key must not start with '/'"}},
+		},
+		{
+			name: "workspaces and filters",
+			args: &GetWorkspaceReferencesArgs{
+				BackendArgs: BackendArgs{BackendType: "local"},
+				Workspaces:  []string{"staging"},
+				Prefix:      ptr("network-"),
+			},
+			want: []p.CheckFailure{
+				{Property: "workspaces", Reason: "conflicts with prefix"},
+				{Property: "prefix", Reason: "conflicts with workspaces"},
+			},
+		},
+		{
+			name: "invalid regex and timeout",
+			args: &GetWorkspaceReferencesArgs{
+				BackendArgs: BackendArgs{BackendType: "local"},
+				Regex:       ptr("("),
+				ReadArgs:    ReadArgs{Timeout: ptr("soon")},
+			},
+			want: []p.CheckFailure{
+				{Property: "regex", Reason: "invalid regular expression: error parsing regexp: missing closing ): `(`"},
+				{Property: "timeout", Reason: `invalid timeout "soon": time: invalid duration "soon"`},
+			},
+		},
+		{
+			name: "non-positive timeout",
+			args: &ListWorkspacesArgs{
+				BackendArgs: BackendArgs{BackendType: "local"},
+				ReadArgs:    ReadArgs{Timeout: ptr("0s")},
+			},
+			want: []p.CheckFailure{{Property: "timeout", Reason: `invalid timeout "0s": must be positive`}},
+		},
+		{
+			name: "valid",
+			args: &GetLocalReferenceArgs{Path: ptr("testdata/test.tfstate")},
+		},
+		{
+			name: "unsupported backend type",
+			args: &GetBackendReferenceArgs{BackendArgs: BackendArgs{BackendType: "nonexistent"}},
+			want: []p.CheckFailure{{Property: "backendType", Reason: `unsupported backend type "nonexistent"`}},
+		},
+		{
+			name: "invalid secret config",
+			args: &GetOutputArgs{
+				BackendArgs: BackendArgs{
+					BackendType:  "local",
+					SecretConfig: map[string]any{"workspace_dir": []any{"dir"}},
+				},
+			},
+			want: []p.CheckFailure{{
+				Property: "secretConfig.workspace_dir",
+				Reason:   `Inappropriate value for argument "workspace_dir": string is required.`,
+			}},
+		},
+		{
+			name: "backend validation of config",
+			args: &ListWorkspacesArgs{
+				BackendArgs: BackendArgs{
+					BackendType: "s3",
+					Config:      map[string]any{"bucket": "state", "key": "/prod.tfstate", "region": "us-west-2"},
+				},
+			},
+			want: []p.CheckFailure{{Property: "config.key", Reason: "key must not start with '/'"}},
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.args.check(withConfig(t.Context(), tt.config)))
+		})
+	}
+}
+
+// TestInvokeCheckFailures checks that functions fail with the failures of their
+// arguments before contacting the backend.
+func TestInvokeCheckFailures(t *testing.T) {
+	InitTfBackend()
+
+	_, err := (&GetRemoteReference{}).Invoke(t.Context(), infer.FunctionRequest[GetRemoteReferenceArgs]{
+		Input: GetRemoteReferenceArgs{
+			Hostname:     ptr("127.0.0.1:1"),
+			Organization: "org",
+			Workspaces:   Workspaces{Name: ptr("app"), Prefix: ptr("app-")},
+		},
+	})
+
+	var failures CheckFailures
+	require.ErrorAs(t, err, &failures)
+	assert.Equal(t, CheckFailures{
+		{Property: "workspaces.name", Reason: "conflicts with workspaces.prefix"},
+		{Property: "workspaces.prefix", Reason: "conflicts with workspaces.name"},
+	}, failures)
+	assert.EqualError(t, err, "invalid arguments: workspaces.name: conflicts with workspaces.prefix; "+
+		"workspaces.prefix: conflicts with workspaces.name")
+}
+
+func TestReferenceCheck(t *testing.T) {
+	InitTfBackend()
+
+	inputs := func(config property.Value) property.Map {
+		return property.NewMap(map[string]property.Value{
+			"backendType": property.New("local"),
+			"config":      config,
+		})
+	}
+
+	t.Run("invalid config", func(t *testing.T) {
+		resp, err := (&Reference{}).Check(t.Context(), infer.CheckRequest{
+			NewInputs: inputs(property.New(map[string]property.Value{"bucket": property.New("state")})),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{{
+			Property: "config.bucket",
+			Reason:   `An argument named "bucket" is not expected by the local backend.`,
+		}}, resp.Failures)
+	})
+
+	t.Run("valid config", func(t *testing.T) {
+		resp, err := (&Reference{}).Check(t.Context(), infer.CheckRequest{
+			NewInputs: inputs(property.New(map[string]property.Value{
+				localPathAttribute: property.New("testdata/test.tfstate"),
+			})),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Failures)
+		assert.Equal(t, ptr(defaultWorkspace), resp.Inputs.Workspace)
+	})
+
+	t.Run("unknown config", func(t *testing.T) {
+		resp, err := (&Reference{}).Check(t.Context(), infer.CheckRequest{
+			NewInputs: inputs(property.New(map[string]property.Value{"bucket": property.New(property.Computed)})),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Failures)
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetCloudReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetCloudReference)(nil))
+	_ = (infer.ExplicitDependencies[GetCloudReferenceArgs, StateReferenceOutputs])((*GetCloudReference)(nil))
+)
+
+func (r *GetCloudReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in HCP Terraform or Terraform Enterprise, as configured by a cloud block.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/cli/cloud/settings#the-cloud-block
+//
+// project is not exposed: it only decides where new workspaces are created, and
+// the cloud backend vendored from Terraform 1.5.7 has no such attribute.
+type GetCloudReferenceArgs struct {
+	Hostname     *string         `pulumi:"hostname,optional"`
+	Organization *string         `pulumi:"organization,optional"`
+	Token        *string         `pulumi:"token,optional" provider:"secret"`
+	Workspaces   CloudWorkspaces `pulumi:"workspaces"`
+	Workspace    *string         `pulumi:"workspace,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetCloudReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Hostname, "The hostname of HCP Terraform or Terraform Enterprise. Falls back to the "+
+		"TF_CLOUD_HOSTNAME environment variable, and then to app.terraform.io, when unset.")
+	a.Describe(&r.Organization, "The name of the organization containing the targeted workspace. Falls back "+
+		"to the TF_CLOUD_ORGANIZATION environment variable when unset.")
+	a.Describe(&r.Token, "The token used to authenticate with HCP Terraform or Terraform Enterprise.")
+	a.Describe(&r.Workspaces, "The workspaces the cloud block maps to.")
+	a.Describe(&r.Workspace, "The name of the workspace to read, required when workspaces are selected by "+
+		"tags. Ignored when workspaces.name is set.")
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the token (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetCloudReference) WireDependencies(
+	f infer.FieldSelector, _ *GetCloudReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+type CloudWorkspaces struct {
+	Name *string  `pulumi:"name,optional"`
+	Tags []string `pulumi:"tags,optional"`
+}
+
+func (r *CloudWorkspaces) Annotate(a infer.Annotator) {
+	a.Describe(&r.Name, "The name of a single workspace. This option conflicts with tags.")
+	a.Describe(&r.Tags, "The tags shared by the workspaces the configuration maps to. Use workspace to "+
+		"choose which of them to read. This option conflicts with name.")
+}
+
+// stateMgrName returns the workspace name to pass to the cloud backend's StateMgr
+// method. Unlike the remote backend, the cloud backend has no "default" sentinel:
+// it expects the real workspace name, even when workspaces.name is set.
+func (r *GetCloudReferenceArgs) stateMgrName() string {
+	if r.Workspaces.Name != nil {
+		return *r.Workspaces.Name
+	}
+	return stringOrZero(r.Workspace)
+}
+
+// backendConfig builds the cloud backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetCloudReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"hostname":     ctyStringOrNil(r.Hostname),
+		"organization": ctyStringOrNil(r.Organization),
+		"token":        ctyStringOrNil(r.Token),
+		"workspaces": cty.ObjectVal(map[string]cty.Value{
+			"name": ctyStringOrNil(r.Workspaces.Name),
+			"tags": ctyStringSetOrNil(r.Workspaces.Tags),
+		}),
+	}
+}
+
+// check validates the arguments before reading. With workspaces.tags, the backend
+// reads the state of workspace, so it must be set.
+func (r *GetCloudReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	if failures := checkBackend(ctx, "cloud", r.backendConfig(), r.Env, argumentPath); len(failures) > 0 {
+		return failures
+	}
+	if len(r.Workspaces.Tags) > 0 && r.Workspaces.Name == nil && r.Workspace == nil {
+		return []p.CheckFailure{{
+			Property: "workspace",
+			Reason:   "must be set to choose which of the workspaces with workspaces.tags to read",
+		}}
+	}
+	return nil
+}
+
+func (r *GetCloudReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetCloudReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "cloud", args.stateMgrName(), args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
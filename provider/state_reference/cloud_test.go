@@ -0,0 +1,309 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/jsonapi"
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	cloudOrganization = "pulumi"
+	cloudToken        = "team-token"
+	cloudWorkspace    = "network"
+	cloudWorkspaceID  = "ws-network"
+
+	// fakeTFEHostnameEnv carries the fake TFE API server's host:port from
+	// TestStateReferenceReadCloud into the child process that reads from it.
+	fakeTFEHostnameEnv = "PULUMI_TERRAFORM_TEST_FAKE_TFE_HOSTNAME"
+)
+
+// TestCloudBackendConfigMatchesSchema validates backendConfig against the real cloud
+// backend schema, the same way TestAzureRMBackendConfigMatchesSchema does.
+func TestCloudBackendConfigMatchesSchema(t *testing.T) {
+	InitTfBackend()
+	backend := shim.BackendFactory("cloud")()
+
+	tests := []struct {
+		name string
+		args GetCloudReferenceArgs
+	}{
+		{
+			name: "workspace name",
+			args: GetCloudReferenceArgs{
+				Organization: ptr(cloudOrganization),
+				Workspaces:   CloudWorkspaces{Name: ptr(cloudWorkspace)},
+			},
+		},
+		{
+			name: "workspace tags",
+			args: GetCloudReferenceArgs{
+				Hostname:     ptr("tfe.example.com"),
+				Organization: ptr(cloudOrganization),
+				Token:        ptr(cloudToken),
+				Workspaces:   CloudWorkspaces{Tags: []string{"app", "prod"}},
+				Workspace:    ptr(cloudWorkspace),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coerced, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(tt.args.backendConfig()))
+			require.NoError(t, err)
+
+			_, diags := backend.PrepareConfig(coerced)
+			require.False(t, diags.HasErrors(), "PrepareConfig: %v", diags.Err())
+		})
+	}
+}
+
+func TestCloudStateMgrName(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     GetCloudReferenceArgs
+		expected string
+	}{
+		{
+			name:     "name passes the real workspace name",
+			args:     GetCloudReferenceArgs{Workspaces: CloudWorkspaces{Name: ptr(cloudWorkspace)}},
+			expected: cloudWorkspace,
+		},
+		{
+			name: "tags pass the selected workspace",
+			args: GetCloudReferenceArgs{
+				Workspaces: CloudWorkspaces{Tags: []string{"app"}},
+				Workspace:  ptr(cloudWorkspace),
+			},
+			expected: cloudWorkspace,
+		},
+		{
+			name: "name takes precedence over workspace",
+			args: GetCloudReferenceArgs{
+				Workspaces: CloudWorkspaces{Name: ptr(cloudWorkspace)},
+				Workspace:  ptr("other"),
+			},
+			expected: cloudWorkspace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.args.stateMgrName())
+		})
+	}
+}
+
+// TestStateReferenceReadCloud reads Terraform state from a fake TFE API server.
+//
+// Both service discovery and the go-tfe client only trust the system roots, so the
+// fake server's certificate has to be installed through SSL_CERT_FILE. Go reads
+// that once per process, so the test re-runs itself in a child process that has it
+// set from the start.
+func TestStateReferenceReadCloud(t *testing.T) {
+	hostname, ok := os.LookupEnv(fakeTFEHostnameEnv)
+	if !ok {
+		server, writes := fakeTFEAPI(t)
+		certFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(certFile,
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+		cmd := exec.CommandContext(t.Context(), os.Args[0], "-test.run=^"+t.Name()+"$", "-test.v")
+		cmd.Env = append(os.Environ(),
+			"SSL_CERT_FILE="+certFile,
+			fakeTFEHostnameEnv+"="+server.Listener.Addr().String())
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, "%s", out)
+		// The cloud backend creates and tags the workspaces it opens as needed, which
+		// reading must never do.
+		assert.Empty(t, writes())
+		return
+	}
+
+	tests := []struct {
+		name    string
+		args    GetCloudReferenceArgs
+		wantErr string
+	}{
+		{
+			name: "workspace name",
+			args: GetCloudReferenceArgs{
+				Token:      ptr(cloudToken),
+				Workspaces: CloudWorkspaces{Name: ptr(cloudWorkspace)},
+			},
+		},
+		{
+			name: "workspace tags",
+			args: GetCloudReferenceArgs{
+				Token:      ptr(cloudToken),
+				Workspaces: CloudWorkspaces{Tags: []string{"app", "prod"}},
+				Workspace:  ptr(cloudWorkspace),
+			},
+		},
+		{
+			name: "missing workspace",
+			args: GetCloudReferenceArgs{
+				Token:      ptr(cloudToken),
+				Workspaces: CloudWorkspaces{Name: ptr("missing")},
+			},
+			wantErr: string(shim.ReasonWorkspaceNotFound),
+		},
+		{
+			name: "untagged workspace",
+			args: GetCloudReferenceArgs{
+				Token:      ptr(cloudToken),
+				Workspaces: CloudWorkspaces{Tags: []string{"app", "staging"}},
+				Workspace:  ptr(cloudWorkspace),
+			},
+			wantErr: string(shim.ReasonWorkspaceNotFound),
+		},
+		{
+			name: "wrong token",
+			args: GetCloudReferenceArgs{
+				Token:      ptr("wrong-token"),
+				Workspaces: CloudWorkspaces{Name: ptr(cloudWorkspace)},
+			},
+			wantErr: "unauthorized",
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Hostname = ptr(hostname)
+			tt.args.Organization = ptr(cloudOrganization)
+
+			resp, err := (&GetCloudReference{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetCloudReferenceArgs]{Input: tt.args})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+}
+
+// fakeTFEAPI serves testdata/test.tfstate as the current state version of
+// cloudWorkspace, answering just the requests the cloud backend makes to list its
+// workspaces and read it.
+// The workspace pins an old Terraform version, so reads only succeed when the
+// backend's version conflict check is skipped. Only requests carrying cloudToken
+// are authorized. writes returns the requests made to change anything, which the
+// server refuses.
+func fakeTFEAPI(t *testing.T) (server *httptest.Server, writes func() []string) {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+
+	writePayload := func(w http.ResponseWriter, model any) {
+		w.Header().Set("Content-Type", jsonapi.MediaType)
+		require.NoError(t, jsonapi.MarshalPayload(w, model))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/terraform.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"tfe.v2": "/api/v2/"}`))
+	})
+	mux.HandleFunc("GET /api/v2/ping", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("TFP-API-Version", "2.5")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /api/v2/organizations/"+cloudOrganization+"/entitlement-set",
+		func(w http.ResponseWriter, _ *http.Request) {
+			writePayload(w, &tfe.Entitlements{ID: "org-" + cloudOrganization, Operations: true})
+		})
+	workspace := &tfe.Workspace{
+		ID:               cloudWorkspaceID,
+		Name:             cloudWorkspace,
+		TerraformVersion: "0.12.31",
+		TagNames:         []string{"app", "prod"},
+	}
+	mux.HandleFunc("GET /api/v2/organizations/"+cloudOrganization+"/workspaces",
+		func(w http.ResponseWriter, r *http.Request) {
+			workspaces := []*tfe.Workspace{}
+			tags := r.URL.Query().Get("search[tags]")
+			if tags == "" || !slices.ContainsFunc(strings.Split(tags, ","), func(tag string) bool {
+				return !slices.Contains(workspace.TagNames, tag)
+			}) {
+				workspaces = append(workspaces, workspace)
+			}
+			writePayload(w, workspaces)
+		})
+	mux.HandleFunc("GET /api/v2/organizations/"+cloudOrganization+"/workspaces/"+cloudWorkspace,
+		func(w http.ResponseWriter, _ *http.Request) {
+			writePayload(w, workspace)
+		})
+	mux.HandleFunc("GET /api/v2/workspaces/"+cloudWorkspaceID+"/current-state-version",
+		func(w http.ResponseWriter, r *http.Request) {
+			writePayload(w, &tfe.StateVersion{
+				ID:          "sv-current",
+				DownloadURL: "https://" + r.Host + "/api/state-versions/sv-current/download",
+			})
+		})
+	mux.HandleFunc("GET /api/state-versions/sv-current/download", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(state)
+	})
+
+	var mu sync.Mutex
+	var written []string
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/terraform.json" && r.Header.Get("Authorization") != "Bearer "+cloudToken {
+			w.Header().Set("Content-Type", jsonapi.MediaType)
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors": [{"status": "401", "title": "unauthorized"}]}`))
+			return
+		}
+		if r.Method != http.MethodGet {
+			mu.Lock()
+			written = append(written, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(written)
+	}
+}
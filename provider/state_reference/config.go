@@ -0,0 +1,245 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// defaultCacheTTL is how long a cached state is reused when cacheTtl is unset.
+const defaultCacheTTL = "5m"
+
+// stateCache holds the states read by this provider process when caching is enabled.
+var stateCache = shim.NewStateCache()
+
+// hideTerraformLogs records the showTerraformLogs setting of the provider. Log
+// messages are redirected around every call to the provider, outside of the context
+// infer provides the configuration in.
+var hideTerraformLogs atomic.Bool
+
+// configured holds the configuration of the provider once Configure accepts it.
+var configured atomic.Pointer[Config]
+
+// ShowTerraformLogs reports whether the log messages of Terraform's backends should be
+// shown at their own level.
+func ShowTerraformLogs() bool { return !hideTerraformLogs.Load() }
+
+// Config is the configuration of the provider.
+type Config struct {
+	Timeout *string `pulumi:"timeout,optional"`
+
+	CacheStateReads *bool   `pulumi:"cacheStateReads,optional"`
+	CacheTTL        *string `pulumi:"cacheTtl,optional"`
+
+	ShowTerraformLogs *bool `pulumi:"showTerraformLogs,optional"`
+
+	DisableEnvFallback *bool `pulumi:"disableEnvFallback,optional"`
+
+	S3Defaults      *S3Defaults      `pulumi:"s3Defaults,optional"`
+	AzureRMDefaults *AzureRMDefaults `pulumi:"azurermDefaults,optional"`
+	GcsDefaults     *GcsDefaults     `pulumi:"gcsDefaults,optional"`
+	RemoteDefaults  *RemoteDefaults  `pulumi:"remoteDefaults,optional"`
+}
+
+var (
+	_ = (infer.Annotated)((*Config)(nil))
+	_ = (infer.CustomConfigure)((*Config)(nil))
+)
+
+func (c *Config) Annotate(a infer.Annotator) {
+	a.Describe(&c.Timeout, "The default time to wait for state to be read, as a duration such as 30s or 2m. "+
+		"Functions override it with their own timeout argument, but reads shared through the cache are always "+
+		"bounded by it. Reads are unbounded when unset.")
+
+	a.Describe(&c.CacheStateReads, "Whether to remember the state read by functions and resources, so reading "+
+		"the same workspace with the same backend configuration again within cacheTtl does not fetch it again. "+
+		"Concurrent identical reads share a single fetch either way when enabled.")
+	a.Describe(&c.CacheTTL, "How long a cached state is reused, as a duration such as 30s or 5m.")
+
+	a.Describe(&c.ShowTerraformLogs, "Whether the log messages of Terraform's backends are shown at their own "+
+		"level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. "+
+		"Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to "+
+		"warnings and errors.")
+
+	a.Describe(&c.DisableEnvFallback, "Whether to hide the environment variables of the provider process that "+
+		"backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, "+
+		"so reads only depend on their arguments and the env argument of functions.")
+
+	a.Describe(&c.S3Defaults, "Fallbacks for the arguments of every read from the s3 backend. Arguments set "+
+		"on a function take precedence, and environment variables are only consulted when neither is set.")
+	a.Describe(&c.AzureRMDefaults, "Fallbacks for the arguments of every read from the azurerm backend. "+
+		"Arguments set on a function take precedence, and environment variables are only consulted when neither "+
+		"is set.")
+	a.Describe(&c.GcsDefaults, "Fallbacks for the arguments of every read from the gcs backend. Arguments set "+
+		"on a function take precedence, and environment variables are only consulted when neither is set.")
+	a.Describe(&c.RemoteDefaults, "Fallbacks for the arguments of every read from the remote and cloud "+
+		"backends. Arguments set on a function take precedence.")
+
+	a.SetDefault(&c.CacheTTL, defaultCacheTTL)
+	a.SetDefault(&c.ShowTerraformLogs, true)
+}
+
+// Configure rejects invalid durations when the provider is configured, instead of on
+// the first read, and records the configuration for WithProviderConfig.
+func (c *Config) Configure(context.Context) error {
+	hideTerraformLogs.Store(c.ShowTerraformLogs != nil && !*c.ShowTerraformLogs)
+
+	if _, err := parseDuration("timeout", c.Timeout); err != nil {
+		return err
+	}
+	if _, err := parseDuration("cacheTtl", c.CacheTTL); err != nil {
+		return err
+	}
+	configured.Store(c)
+	return nil
+}
+
+type configKey struct{}
+
+// WithProviderConfig returns a copy of ctx carrying the configuration of the
+// provider, for the functions and resources called with it. ctx is returned as is
+// until the provider is configured.
+func WithProviderConfig(ctx context.Context) context.Context {
+	c := configured.Load()
+	if c == nil {
+		return ctx
+	}
+	return withConfig(ctx, *c)
+}
+
+// withConfig returns a copy of ctx carrying c.
+func withConfig(ctx context.Context, c Config) context.Context {
+	return context.WithValue(ctx, configKey{}, c)
+}
+
+// providerConfig returns the configuration ctx carries. Functions invoked outside of
+// a configured provider, as in tests, see the zero Config.
+func providerConfig(ctx context.Context) Config {
+	c, _ := ctx.Value(configKey{}).(Config)
+	return c
+}
+
+// backendDefaults returns the fallback configuration of the backendType backend,
+// keyed by the backend's attribute names.
+func (c Config) backendDefaults(backendType string) map[string]cty.Value {
+	switch backendType {
+	case "s3":
+		return c.S3Defaults.backendConfig()
+	case "azurerm":
+		return c.AzureRMDefaults.backendConfig()
+	case "gcs":
+		return c.GcsDefaults.backendConfig()
+	case "remote", "cloud":
+		return c.RemoteDefaults.backendConfig()
+	default:
+		return nil
+	}
+}
+
+// withBackendDefaults returns config with the attributes it leaves unset filled from
+// the defaults for the backendType backend.
+func (c Config) withBackendDefaults(backendType string, config map[string]cty.Value) map[string]cty.Value {
+	defaults := c.backendDefaults(backendType)
+	if len(defaults) == 0 {
+		return config
+	}
+
+	merged := maps.Clone(config)
+	if merged == nil {
+		merged = make(map[string]cty.Value, len(defaults))
+	}
+	for k, v := range defaults {
+		if current, ok := merged[k]; !v.IsNull() && (!ok || current.IsNull()) {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// environment returns the environment a read setting vars runs in.
+func (c Config) environment(vars map[string]string) shim.Environment {
+	return shim.Environment{
+		Vars:            vars,
+		DisableFallback: c.DisableEnvFallback != nil && *c.DisableEnvFallback,
+	}
+}
+
+// readState reads the state of workspace, through the cache when it is enabled.
+func (c Config) readState(
+	ctx context.Context, backendType, workspace string, config map[string]cty.Value, env shim.Environment,
+) (*shim.State, error) {
+	if c.CacheStateReads == nil || !*c.CacheStateReads {
+		return shim.StateReferenceRead(ctx, backendType, workspace, config, env)
+	}
+
+	cacheTTL := defaultCacheTTL
+	if c.CacheTTL != nil {
+		cacheTTL = *c.CacheTTL
+	}
+	ttl, err := parseDuration("cacheTtl", &cacheTTL)
+	if err != nil {
+		return nil, argumentError(backendType, err)
+	}
+	// Reads shared through the cache are bounded by the timeout of the provider, as
+	// the calls sharing them may set their own.
+	timeout, err := parseDuration("timeout", c.Timeout)
+	if err != nil {
+		return nil, argumentError(backendType, err)
+	}
+	return stateCache.Read(ctx, backendType, workspace, config, env, ttl, timeout)
+}
+
+// parseDuration parses the duration argument named arg. An unset duration is
+// returned as 0.
+func parseDuration(arg string, value *string) (time.Duration, error) {
+	if value == nil {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(*value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", arg, *value, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: must be positive", arg, *value)
+	}
+	return d, nil
+}
+
+// withTimeout bounds ctx by timeout, or by the provider's default timeout when
+// timeout is unset.
+func withTimeout(ctx context.Context, timeout *string) (context.Context, context.CancelFunc, error) {
+	if timeout == nil {
+		timeout = providerConfig(ctx).Timeout
+	}
+	d, err := parseDuration("timeout", timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d == 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, cancel, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, nil
+}
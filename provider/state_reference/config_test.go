@@ -0,0 +1,479 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestProviderConfig checks that calls see the configuration the provider last
+// accepted, and the zero Config before it is configured.
+func TestProviderConfig(t *testing.T) {
+	t.Cleanup(func() {
+		configured.Store(nil)
+		hideTerraformLogs.Store(false)
+	})
+
+	assert.Equal(t, Config{}, providerConfig(WithProviderConfig(t.Context())))
+
+	require.NoError(t, (&Config{Timeout: ptr("30s")}).Configure(t.Context()))
+	assert.Equal(t, ptr("30s"), providerConfig(WithProviderConfig(t.Context())).Timeout)
+
+	require.Error(t, (&Config{Timeout: ptr("soon")}).Configure(t.Context()))
+	assert.Equal(t, ptr("30s"), providerConfig(WithProviderConfig(t.Context())).Timeout)
+}
+
+// hangingServer returns a server whose requests never complete while the test runs.
+func hangingServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	return server
+}
+
+func TestStateReferenceReadTimeout(t *testing.T) {
+	server := hangingServer(t)
+
+	tests := []struct {
+		name     string
+		ctx      func(t *testing.T) context.Context
+		timeout  *string
+		wantCode codes.Code
+		wantErr  string
+	}{
+		{
+			name:     "timeout",
+			ctx:      func(t *testing.T) context.Context { return t.Context() },
+			timeout:  ptr("100ms"),
+			wantCode: codes.DeadlineExceeded,
+			wantErr:  "while fetching the state (TIMEOUT): context deadline exceeded",
+		},
+		{
+			name: "canceled",
+			ctx: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithCancel(t.Context())
+				time.AfterFunc(100*time.Millisecond, cancel)
+				return ctx
+			},
+			wantCode: codes.Canceled,
+			wantErr:  "while fetching the state (CANCELED): context canceled",
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			_, err := (&GetHTTPReference{}).Invoke(tt.ctx(t), infer.FunctionRequest[GetHTTPReferenceArgs]{
+				Input: GetHTTPReferenceArgs{Address: server.URL, ReadArgs: ReadArgs{Timeout: tt.timeout}},
+			})
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), tt.wantErr)
+			assert.Less(t, time.Since(start), 5*time.Second)
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		timeout *string
+		want    time.Duration
+		wantErr string
+	}{
+		{timeout: nil, want: 0},
+		{timeout: ptr("90s"), want: 90 * time.Second},
+		{timeout: ptr("2m30s"), want: 150 * time.Second},
+		{timeout: ptr("soon"), wantErr: `invalid timeout "soon"`},
+		{timeout: ptr("0s"), wantErr: `invalid timeout "0s": must be positive`},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDuration("timeout", tt.timeout)
+		if tt.wantErr != "" {
+			assert.ErrorContains(t, err, tt.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestWithBackendDefaults(t *testing.T) {
+	config := Config{S3Defaults: &S3Defaults{
+		Region:  ptr("us-west-2"),
+		RoleArn: ptr("arn:aws:iam::123456789012:role/state-reader"),
+	}}
+
+	call := map[string]cty.Value{
+		"bucket":   cty.StringVal("state"),
+		"region":   cty.StringVal("eu-west-1"),
+		"role_arn": cty.NullVal(cty.String),
+	}
+	assert.Equal(t, map[string]cty.Value{
+		"bucket":   cty.StringVal("state"),
+		"region":   cty.StringVal("eu-west-1"),
+		"role_arn": cty.StringVal("arn:aws:iam::123456789012:role/state-reader"),
+	}, config.withBackendDefaults("s3", call))
+	assert.Equal(t, cty.NullVal(cty.String), call["role_arn"], "the call's configuration is not modified")
+
+	assert.Equal(t, call, config.withBackendDefaults("gcs", call), "defaults only apply to their backend")
+	assert.Equal(t, call, Config{}.withBackendDefaults("s3", call))
+}
+
+// TestBackendDefaultsMatchSchema validates the configuration every defaults type
+// provides against the schema of the backends it applies to.
+func TestBackendDefaultsMatchSchema(t *testing.T) {
+	config := Config{
+		S3Defaults: &S3Defaults{
+			Region:                    ptr("us-west-2"),
+			Endpoint:                  ptr("https://s3.example.com"),
+			StsEndpoint:               ptr("https://sts.example.com"),
+			IamEndpoint:               ptr("https://iam.example.com"),
+			ForcePathStyle:            ptr(true),
+			AccessKey:                 ptr("access-key"),
+			SecretKey:                 ptr("secret-key"),
+			Token:                     ptr("session-token"),
+			Profile:                   ptr("reader"),
+			SharedCredentialsFile:     ptr("/path/to/credentials"),
+			RoleArn:                   ptr("arn:aws:iam::123456789012:role/state-reader"),
+			SessionName:               ptr("pulumi"),
+			ExternalID:                ptr("external-id"),
+			AssumeRoleDurationSeconds: ptr(900),
+			MaxRetries:                ptr(3),
+			SkipCredentialsValidation: ptr(true),
+			SkipRegionValidation:      ptr(true),
+			SkipMetadataAPICheck:      ptr(true),
+		},
+		AzureRMDefaults: &AzureRMDefaults{
+			Environment:               ptr("public"),
+			MetadataHost:              ptr("management.azure.com"),
+			Endpoint:                  ptr("https://management.azure.com"),
+			SubscriptionID:            ptr("00000000-0000-0000-0000-000000000000"),
+			TenantID:                  ptr("00000000-0000-0000-0000-000000000000"),
+			ClientID:                  ptr("00000000-0000-0000-0000-000000000000"),
+			ClientSecret:              ptr("client-secret"),
+			ClientCertificatePath:     ptr("/path/to/cert.pfx"),
+			ClientCertificatePassword: ptr("cert-password"),
+			UseMsi:                    ptr(true),
+			MsiEndpoint:               ptr("http://169.254.169.254/metadata/identity/oauth2/token"),
+			UseOidc:                   ptr(true),
+			OidcToken:                 ptr("oidc-token"),
+			OidcTokenFilePath:         ptr("/path/to/token"),
+			OidcRequestURL:            ptr("https://token.actions.githubusercontent.com"),
+			OidcRequestToken:          ptr("request-token"),
+			UseAzureadAuth:            ptr(true),
+		},
+		GcsDefaults: &GcsDefaults{
+			Credentials:                        ptr("{}"),
+			AccessToken:                        ptr("access-token"),
+			ImpersonateServiceAccount:          ptr("reader@project.iam.gserviceaccount.com"),
+			ImpersonateServiceAccountDelegates: []string{"delegate@project.iam.gserviceaccount.com"},
+			StorageCustomEndpoint:              ptr("https://storage.example.com/storage/v1/"),
+		},
+		RemoteDefaults: &RemoteDefaults{
+			Hostname: ptr("tfe.example.com"),
+			Token:    ptr("tfe-token"),
+		},
+	}
+
+	tests := []struct {
+		backendType string
+		call        map[string]cty.Value
+	}{
+		{
+			backendType: "s3",
+			call:        map[string]cty.Value{"bucket": cty.StringVal("state"), "key": cty.StringVal("tfstate")},
+		},
+		{
+			backendType: "azurerm",
+			call: map[string]cty.Value{
+				"storage_account_name": cty.StringVal("account"),
+				"container_name":       cty.StringVal("tfstate"),
+				"key":                  cty.StringVal("prod.terraform.tfstate"),
+			},
+		},
+		{
+			backendType: "gcs",
+			call:        map[string]cty.Value{"bucket": cty.StringVal("state")},
+		},
+		{
+			backendType: "remote",
+			call:        map[string]cty.Value{"organization": cty.StringVal("pulumi")},
+		},
+		{
+			backendType: "cloud",
+			call:        map[string]cty.Value{"organization": cty.StringVal("pulumi")},
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.backendType, func(t *testing.T) {
+			merged := config.withBackendDefaults(tt.backendType, tt.call)
+			require.Greater(t, len(merged), len(tt.call))
+
+			backend := shim.BackendFactory(tt.backendType)()
+			_, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(merged))
+			require.NoError(t, err)
+		})
+	}
+}
+
+// countingStateServer serves testdata/test.tfstate, counting the requests for it, and
+// returns its address. Requests block until release is closed, when it is set.
+//
+// The address is unique, since cached states outlive the test.
+func countingStateServer(t *testing.T, release chan struct{}) (string, *atomic.Int32) {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if release != nil {
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(state)
+	}))
+	t.Cleanup(server.Close)
+	return server.URL + "/" + rand.Text(), &requests
+}
+
+func TestConfigReadStateCache(t *testing.T) {
+	InitTfBackend()
+	httpConfig := func(address string) map[string]cty.Value {
+		return map[string]cty.Value{"address": cty.StringVal(address)}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		address, requests := countingStateServer(t, nil)
+		for range 3 {
+			_, err := Config{}.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+			require.NoError(t, err)
+		}
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("concurrent reads share one fetch", func(t *testing.T) {
+		address, requests := countingStateServer(t, nil)
+		config := Config{CacheStateReads: ptr(true)}
+
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				state, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+				assert.NoError(t, err)
+				assert.Equal(t, "hello", state.Outputs["greeting"])
+			})
+		}
+		wg.Wait()
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		address, requests := countingStateServer(t, nil)
+		config := Config{CacheStateReads: ptr(true), CacheTTL: ptr("50ms")}
+
+		for range 2 {
+			_, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+			require.NoError(t, err)
+		}
+		assert.EqualValues(t, 1, requests.Load())
+
+		time.Sleep(100 * time.Millisecond)
+		_, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	t.Run("a caller giving up does not fail the shared read", func(t *testing.T) {
+		release := make(chan struct{})
+		address, requests := countingStateServer(t, release)
+		config := Config{CacheStateReads: ptr(true)}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		_, err := config.readState(ctx, "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+		time.AfterFunc(100*time.Millisecond, func() { close(release) })
+		state, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", state.Outputs["greeting"])
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("reads are only shared between calls with the same secrets", func(t *testing.T) {
+		release := make(chan struct{})
+		address, requests := countingStateServer(t, release)
+		config := Config{CacheStateReads: ptr(true)}
+
+		var wg sync.WaitGroup
+		for _, secret := range []string{"secret-of-a", "secret-of-a", "secret-of-b"} {
+			ctx := withSecrets(WithSecretRedaction(t.Context()), struct {
+				Secret string `provider:"secret"`
+			}{secret})
+			wg.Go(func() {
+				_, err := config.readState(ctx, "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+				assert.NoError(t, err)
+			})
+		}
+		assert.Eventually(t, func() bool { return requests.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	t.Run("a hung shared read times out for a later call to retry", func(t *testing.T) {
+		release := make(chan struct{})
+		address, requests := countingStateServer(t, release)
+		config := Config{CacheStateReads: ptr(true), Timeout: ptr("100ms")}
+
+		_, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+		close(release)
+		state, err := config.readState(t.Context(), "http", defaultWorkspace, httpConfig(address), shim.Environment{})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", state.Outputs["greeting"])
+		assert.EqualValues(t, 2, requests.Load())
+	})
+}
+
+func TestConfigReadStateEnvironment(t *testing.T) {
+	InitTfBackend()
+	// The http backend falls back to TF_HTTP_ADDRESS when address is unset.
+	noAddress := map[string]cty.Value{}
+
+	t.Run("env is scoped to the whole read", func(t *testing.T) {
+		state, err := os.ReadFile("testdata/test.tfstate")
+		require.NoError(t, err)
+		// The state is fetched after the backend is configured, and still sees the
+		// environment of the read.
+		var seen atomic.Value
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen.Store(os.Getenv("TF_HTTP_ADDRESS"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(state)
+		}))
+		t.Cleanup(server.Close)
+		address := server.URL + "/state"
+		t.Setenv("TF_HTTP_ADDRESS", "http://127.0.0.1:1/unused")
+
+		read, err := Config{}.readState(t.Context(), "http", defaultWorkspace, noAddress,
+			Config{}.environment(map[string]string{"TF_HTTP_ADDRESS": address}))
+		require.NoError(t, err)
+		assert.Equal(t, "hello", read.Outputs["greeting"])
+		assert.Equal(t, address, seen.Load())
+		assert.Equal(t, "http://127.0.0.1:1/unused", os.Getenv("TF_HTTP_ADDRESS"))
+	})
+
+	t.Run("concurrent reads see their own env", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for range 4 {
+			address, requests := countingStateServer(t, nil)
+			wg.Go(func() {
+				_, err := Config{}.readState(t.Context(), "http", defaultWorkspace, noAddress,
+					Config{}.environment(map[string]string{"TF_HTTP_ADDRESS": address}))
+				assert.NoError(t, err)
+				assert.EqualValues(t, 1, requests.Load())
+			})
+		}
+		wg.Wait()
+	})
+
+	t.Run("a hung read only holds up reads in other environments", func(t *testing.T) {
+		release := make(chan struct{})
+		hung, hungRequests := countingStateServer(t, release)
+		var released sync.Once
+		t.Cleanup(func() { released.Do(func() { close(release) }) })
+
+		hungCtx, cancelHung := context.WithCancel(t.Context())
+		go func() {
+			_, _ = Config{}.readState(hungCtx, "http", defaultWorkspace,
+				map[string]cty.Value{"address": cty.StringVal(hung)}, shim.Environment{})
+		}()
+		require.Eventually(t, func() bool { return hungRequests.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+		healthy, healthyRequests := countingStateServer(t, nil)
+		_, err := Config{}.readState(t.Context(), "http", defaultWorkspace,
+			map[string]cty.Value{"address": cty.StringVal(healthy)}, shim.Environment{})
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, healthyRequests.Load())
+
+		// The hung read keeps the environment of the process while it runs, even once
+		// its call gave up on it.
+		cancelHung()
+		scoped, scopedRequests := countingStateServer(t, nil)
+		readScoped := func(ctx context.Context) error {
+			_, err := Config{}.readState(ctx, "http", defaultWorkspace, noAddress,
+				Config{}.environment(map[string]string{"TF_HTTP_ADDRESS": scoped}))
+			return err
+		}
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+		var readErr *shim.ReadError
+		require.ErrorAs(t, readScoped(ctx), &readErr)
+		assert.Equal(t, shim.ReasonTimeout, readErr.Reason)
+		assert.EqualValues(t, 0, scopedRequests.Load())
+
+		released.Do(func() { close(release) })
+		require.NoError(t, readScoped(t.Context()))
+		assert.EqualValues(t, 1, scopedRequests.Load())
+	})
+
+	t.Run("disableEnvFallback hides the process env", func(t *testing.T) {
+		address, requests := countingStateServer(t, nil)
+		t.Setenv("TF_HTTP_ADDRESS", address)
+		config := Config{DisableEnvFallback: ptr(true)}
+
+		_, err := config.readState(t.Context(), "http", defaultWorkspace, noAddress, config.environment(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "address")
+		assert.EqualValues(t, 0, requests.Load())
+		assert.Equal(t, address, os.Getenv("TF_HTTP_ADDRESS"))
+
+		_, err = config.readState(t.Context(), "http", defaultWorkspace, noAddress,
+			config.environment(map[string]string{"TF_HTTP_ADDRESS": address}))
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, requests.Load())
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetConsulReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetConsulReference)(nil))
+	_ = (infer.ExplicitDependencies[GetConsulReferenceArgs, StateReferenceOutputs])((*GetConsulReference)(nil))
+)
+
+func (r *GetConsulReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in the Consul KV store.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/consul#configuration-variables
+//
+// Only the arguments that affect reading state are exposed. gzip is detected on
+// read and is omitted, and lock is always disabled, since a read never needs to
+// hold the state lock.
+type GetConsulReferenceArgs struct {
+	Path       string  `pulumi:"path"`
+	Address    *string `pulumi:"address,optional"`
+	Scheme     *string `pulumi:"scheme,optional"`
+	Datacenter *string `pulumi:"datacenter,optional"`
+	Workspace  *string `pulumi:"workspace,optional"`
+
+	AccessToken *string `pulumi:"accessToken,optional" provider:"secret"`
+	HTTPAuth    *string `pulumi:"httpAuth,optional" provider:"secret"`
+
+	CaFile   *string `pulumi:"caFile,optional"`
+	CertFile *string `pulumi:"certFile,optional"`
+	KeyFile  *string `pulumi:"keyFile,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetConsulReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Path, "The path in the Consul KV store holding the state. When using a non-default "+
+		"workspace, the state path is <path>-env:<workspace>.")
+	a.Describe(&r.Address, "The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. "+
+		"Falls back to the CONSUL_HTTP_ADDR environment variable when unset.")
+	a.Describe(&r.Scheme, "The scheme used to talk to the Consul agent, http or https. Falls back to https "+
+		"when the CONSUL_HTTP_SSL environment variable is set.")
+	a.Describe(&r.Datacenter, "The datacenter to read from. Defaults to the datacenter of the agent.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.Describe(&r.AccessToken, "An ACL token with read access to the path. Falls back to the "+
+		"CONSUL_HTTP_TOKEN environment variable when unset.")
+	a.Describe(&r.HTTPAuth, "HTTP basic authentication credentials, in the form username or "+
+		"username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.")
+
+	a.Describe(&r.CaFile, "The path to a PEM-encoded certificate authority used to verify the agent's "+
+		"certificate. Falls back to the CONSUL_CACERT environment variable when unset.")
+	a.Describe(&r.CertFile, "The path to a PEM-encoded certificate presented to the agent. Requires keyFile. "+
+		"Falls back to the CONSUL_CLIENT_CERT environment variable when unset.")
+	a.Describe(&r.KeyFile, "The path to the PEM-encoded private key of certFile. Falls back to the "+
+		"CONSUL_CLIENT_KEY environment variable when unset.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetConsulReference) WireDependencies(
+	f infer.FieldSelector, _ *GetConsulReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the consul backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetConsulReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"path":         cty.StringVal(r.Path),
+		"address":      ctyStringOrNil(r.Address),
+		"scheme":       ctyStringOrNil(r.Scheme),
+		"datacenter":   ctyStringOrNil(r.Datacenter),
+		"access_token": ctyStringOrNil(r.AccessToken),
+		"http_auth":    ctyStringOrNil(r.HTTPAuth),
+		"ca_file":      ctyStringOrNil(r.CaFile),
+		"cert_file":    ctyStringOrNil(r.CertFile),
+		"key_file":     ctyStringOrNil(r.KeyFile),
+		"lock":         cty.False,
+	}
+}
+
+// check validates the arguments as the consul backend does before reading.
+func (r *GetConsulReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "consul", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetConsulReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetConsulReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "consul", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
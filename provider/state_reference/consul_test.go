@@ -0,0 +1,148 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestStateReferenceReadConsul reads Terraform state from the Consul KV store.
+//
+// A Consul dev agent container holds the state, and the real tofu CLI writes it
+// through its own consul backend, into both the default and a named workspace.
+func TestStateReferenceReadConsul(t *testing.T) {
+	ctx := t.Context()
+	address := startSeededConsul(ctx, t)
+
+	InitTfBackend()
+	for _, workspace := range []string{defaultWorkspace, consulWorkspace} {
+		t.Run(workspace, func(t *testing.T) {
+			resp, err := (&GetConsulReference{}).Invoke(ctx, infer.FunctionRequest[GetConsulReferenceArgs]{
+				Input: GetConsulReferenceArgs{
+					Workspace: ptr(workspace),
+					Path:      consulPath,
+					Address:   ptr(address),
+					Scheme:    ptr("http"),
+				},
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": greeting,
+				"number":   42,
+			}, resp.Output.Outputs)
+		})
+	}
+
+	// Opening a workspace with the consul backend writes an empty state at its
+	// path when it does not exist, so a workspace must be found among those listed
+	// before it is read.
+	t.Run("missing workspace", func(t *testing.T) {
+		_, err := (&GetConsulReference{}).Invoke(ctx, infer.FunctionRequest[GetConsulReferenceArgs]{
+			Input: GetConsulReferenceArgs{
+				Workspace: ptr("missing"),
+				Path:      consulPath,
+				Address:   ptr(address),
+				Scheme:    ptr("http"),
+			},
+		})
+		var readErr *shim.ReadError
+		require.ErrorAs(t, err, &readErr)
+		assert.Equal(t, shim.ReasonWorkspaceNotFound, readErr.Reason)
+
+		// Neither the state nor its lock may have been written.
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			fmt.Sprintf("http://%s/v1/kv/%s-env:missing?recurse", address, consulPath), nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+const (
+	consulPath      = "pulumi-terraform-test/terraform.tfstate"
+	consulWorkspace = "staging"
+)
+
+// startSeededConsul runs a Consul dev agent container holding Terraform state with
+// known outputs and returns its address.
+func startSeededConsul(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	container, err := testcontainers.Run(ctx, "hashicorp/consul:1.20",
+		testcontainers.WithCmd("agent", "-dev", "-client", "0.0.0.0"),
+		testcontainers.WithExposedPorts("8500/tcp"),
+		testcontainers.WithWaitStrategy(wait.ForHTTP("/v1/status/leader").WithPort("8500/tcp")))
+	testcontainers.CleanupContainer(t, container, testcontainers.StopTimeout(0))
+	require.NoError(t, err)
+
+	address, err := container.PortEndpoint(ctx, "8500/tcp", "")
+	require.NoError(t, err)
+
+	seedConsulState(ctx, t, address)
+	return address
+}
+
+// seedConsulState runs tofu apply against the agent, once in the default
+// workspace and once in consulWorkspace.
+func seedConsulState(ctx context.Context, t *testing.T, address string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	config := fmt.Sprintf(`terraform {
+  backend "consul" {
+    address = %q
+    scheme  = "http"
+    path    = %q
+  }
+}
+
+output "greeting" {
+  value = "hello"
+}
+
+output "number" {
+  value = 42
+}
+`, address, consulPath)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(config), 0o600))
+
+	tofu := func(args ...string) {
+		cmd := exec.CommandContext(ctx, "tofu", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "tofu %v failed:\n%s", args, out)
+	}
+	tofu("init", "-input=false")
+	tofu("apply", "-auto-approve", "-input=false")
+	tofu("workspace", "new", consulWorkspace)
+	tofu("apply", "-auto-approve", "-input=false")
+}
@@ -0,0 +1,165 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetCosReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetCosReference)(nil))
+	_ = (infer.ExplicitDependencies[GetCosReferenceArgs, StateReferenceOutputs])((*GetCosReference)(nil))
+)
+
+func (r *GetCosReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in a Tencent Cloud Object Storage (COS) bucket.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/cos#configuration-variables
+//
+// Only the arguments that affect reading state are exposed. encrypt and acl only
+// apply to writes and are omitted. The cos backend vendored from Terraform 1.5.7
+// always talks to myqcloud.com, so there is no endpoint argument.
+type GetCosReferenceArgs struct {
+	Bucket    string  `pulumi:"bucket"`
+	Prefix    *string `pulumi:"prefix,optional"`
+	Key       *string `pulumi:"key,optional"`
+	Region    *string `pulumi:"region,optional"`
+	Workspace *string `pulumi:"workspace,optional"`
+
+	Accelerate *bool `pulumi:"accelerate,optional"`
+
+	SecretID      *string `pulumi:"secretId,optional" provider:"secret"`
+	SecretKey     *string `pulumi:"secretKey,optional" provider:"secret"`
+	SecurityToken *string `pulumi:"securityToken,optional" provider:"secret"`
+
+	AssumeRole *CosAssumeRole `pulumi:"assumeRole,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetCosReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Bucket, "The name of the COS bucket, in the form <name>-<appid>.")
+	a.Describe(&r.Prefix, "The directory in the bucket holding the state file.")
+	a.Describe(&r.Key, "The name of the state file inside prefix. When using a non-default workspace, "+
+		"the state path is <prefix>/<workspace>/<key>.")
+	a.Describe(&r.Region, "The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment "+
+		"variable when unset.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.Describe(&r.Accelerate, "Whether to read through the global acceleration endpoint of the bucket.")
+
+	a.Describe(&r.SecretID, "Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment "+
+		"variable when unset.")
+	a.Describe(&r.SecretKey, "Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment "+
+		"variable when unset.")
+	a.Describe(&r.SecurityToken, "The security token of temporary credentials. Falls back to the "+
+		"TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.")
+
+	a.Describe(&r.AssumeRole, "A CAM role to assume in order to read the state.")
+
+	a.SetDefault(&r.Key, "terraform.tfstate")
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// CosAssumeRole configures the CAM role the cos backend assumes through STS.
+type CosAssumeRole struct {
+	RoleArn         string  `pulumi:"roleArn"`
+	SessionName     string  `pulumi:"sessionName"`
+	SessionDuration *int    `pulumi:"sessionDuration,optional"`
+	Policy          *string `pulumi:"policy,optional"`
+}
+
+func (r *CosAssumeRole) Annotate(a infer.Annotator) {
+	a.Describe(&r.RoleArn, "The ARN of the role to assume.")
+	a.Describe(&r.SessionName, "The session name to use when assuming the role.")
+	a.Describe(&r.SessionDuration, "The duration, in seconds, of the assume role session, between 0 and "+
+		"43200. Defaults to 7200.")
+	a.Describe(&r.Policy, "A policy further restricting the permissions of the assumed role.")
+}
+
+// ctyValue returns the assume_role block as the single-element set the backend
+// schema expects.
+func (r *CosAssumeRole) ctyValue() cty.Value {
+	ty := cty.Object(map[string]cty.Type{
+		"role_arn":         cty.String,
+		"session_name":     cty.String,
+		"session_duration": cty.Number,
+		"policy":           cty.String,
+	})
+	if r == nil {
+		return cty.NullVal(cty.Set(ty))
+	}
+	return cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"role_arn":         cty.StringVal(r.RoleArn),
+		"session_name":     cty.StringVal(r.SessionName),
+		"session_duration": ctyIntOrNil(r.SessionDuration),
+		"policy":           ctyStringOrNil(r.Policy),
+	})})
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetCosReference) WireDependencies(
+	f infer.FieldSelector, _ *GetCosReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the cos backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetCosReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"bucket":         cty.StringVal(r.Bucket),
+		"prefix":         ctyStringOrNil(r.Prefix),
+		"key":            ctyStringOrNil(r.Key),
+		"region":         ctyStringOrNil(r.Region),
+		"accelerate":     ctyBoolOrNil(r.Accelerate),
+		"secret_id":      ctyStringOrNil(r.SecretID),
+		"secret_key":     ctyStringOrNil(r.SecretKey),
+		"security_token": ctyStringOrNil(r.SecurityToken),
+		"assume_role":    r.AssumeRole.ctyValue(),
+	}
+}
+
+// check validates the arguments as the cos backend does before reading.
+func (r *GetCosReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "cos", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetCosReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetCosReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "cos", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
@@ -0,0 +1,305 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestCosBackendConfigMatchesSchema validates backendConfig against the real cos
+// backend schema, the same way TestAzureRMBackendConfigMatchesSchema does.
+func TestCosBackendConfigMatchesSchema(t *testing.T) {
+	InitTfBackend()
+	backend := shim.BackendFactory("cos")()
+
+	tests := []struct {
+		name string
+		args GetCosReferenceArgs
+	}{
+		{
+			name: "required only",
+			args: GetCosReferenceArgs{Bucket: "state-1250000000", Region: ptr("ap-guangzhou")},
+		},
+		{
+			name: "all arguments",
+			args: GetCosReferenceArgs{
+				Bucket:        "state-1250000000",
+				Prefix:        ptr("network"),
+				Key:           ptr("terraform.tfstate"),
+				Region:        ptr("ap-guangzhou"),
+				Accelerate:    ptr(true),
+				SecretID:      ptr("secret-id"),
+				SecretKey:     ptr("secret-key"),
+				SecurityToken: ptr("security-token"),
+				AssumeRole: &CosAssumeRole{
+					RoleArn:         "qcs::cam::uin/100000000001:roleName/reader",
+					SessionName:     "pulumi",
+					SessionDuration: ptr(3600),
+					Policy:          ptr(`{"version": "2.0"}`),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coerced, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(tt.args.backendConfig()))
+			require.NoError(t, err)
+
+			_, diags := backend.PrepareConfig(coerced)
+			require.False(t, diags.HasErrors(), "PrepareConfig: %v", diags.Err())
+		})
+	}
+}
+
+const (
+	cosBucket    = "state-1250000000"
+	cosRegion    = "ap-guangzhou"
+	cosSecretID  = "cos-secret-id"
+	cosWorkspace = "staging"
+
+	// fakeCosProxyEnv carries the address of the proxy to the fake COS API server from
+	// TestStateReferenceReadCos into the child process that reads through it.
+	fakeCosProxyEnv = "PULUMI_TERRAFORM_TEST_FAKE_COS_PROXY"
+)
+
+// TestStateReferenceReadCos reads Terraform state from a fake COS API server.
+//
+// The cos backend always connects to <bucket>.cos.<region>.myqcloud.com, with no
+// endpoint to override, so requests reach the fake through an HTTPS proxy that
+// tunnels every connection to it. The fake presents a certificate for that host,
+// installed through SSL_CERT_FILE. Go reads both settings once per process, so the
+// test re-runs itself in a child process that has them set from the start.
+func TestStateReferenceReadCos(t *testing.T) {
+	if _, ok := os.LookupEnv(fakeCosProxyEnv); !ok {
+		server, certPEM, writes := fakeCosAPI(t)
+		proxy := tunnelingProxy(t, server.Listener.Addr().String())
+		certFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+
+		cmd := exec.CommandContext(t.Context(), os.Args[0], "-test.run=^"+t.Name()+"$", "-test.v")
+		cmd.Env = append(os.Environ(),
+			"SSL_CERT_FILE="+certFile,
+			"HTTPS_PROXY="+proxy.URL,
+			"NO_PROXY=",
+			fakeCosProxyEnv+"="+proxy.URL)
+		out, err := cmd.CombinedOutput()
+		assert.NoError(t, err, "%s", out)
+		// The cos backend locks and writes an empty state for the workspaces it opens
+		// that do not exist, which reading must never do.
+		assert.Empty(t, writes())
+		return
+	}
+
+	tests := []struct {
+		name    string
+		args    GetCosReferenceArgs
+		wantErr string
+	}{
+		{
+			name: "default workspace",
+			args: GetCosReferenceArgs{Workspace: ptr(defaultWorkspace), SecretID: ptr(cosSecretID)},
+		},
+		{
+			name: "named workspace",
+			args: GetCosReferenceArgs{Workspace: ptr(cosWorkspace), SecretID: ptr(cosSecretID)},
+		},
+		{
+			name:    "missing workspace",
+			args:    GetCosReferenceArgs{Workspace: ptr("missing"), SecretID: ptr(cosSecretID)},
+			wantErr: string(shim.ReasonWorkspaceNotFound),
+		},
+		{
+			name:    "wrong secret id",
+			args:    GetCosReferenceArgs{Workspace: ptr(defaultWorkspace), SecretID: ptr("wrong-secret-id")},
+			wantErr: "AccessDenied",
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Bucket = cosBucket
+			tt.args.Region = ptr(cosRegion)
+			tt.args.SecretKey = ptr("cos-secret-key")
+
+			resp, err := (&GetCosReference{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetCosReferenceArgs]{Input: tt.args})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+}
+
+// fakeCosAPI serves testdata/test.tfstate as the state of both the default
+// workspace and cosWorkspace, at the root of the bucket, over TLS with a
+// certificate for the host of cosBucket, which certPEM holds. Signatures are not
+// checked, but only requests signed with cosSecretID are authorized. writes returns
+// the requests made to change anything, which the server refuses.
+func fakeCosAPI(t *testing.T) (server *httptest.Server, certPEM []byte, writes func() []string) {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+	checksum := md5.Sum(state)
+
+	objects := map[string]bool{
+		"/terraform.tfstate":                      true,
+		"/" + cosWorkspace + "/terraform.tfstate": true,
+	}
+	listing := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult>
+  <Name>%s</Name>
+  <MaxKeys>1000</MaxKeys>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>terraform.tfstate</Key></Contents>
+  <Contents><Key>%s/terraform.tfstate</Key></Contents>
+</ListBucketResult>`, cosBucket, cosWorkspace)
+
+	var mu sync.Mutex
+	var written []string
+	server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !strings.Contains(r.Header.Get("Authorization"), "q-ak="+cosSecretID+"&"):
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>AccessDenied</Code><Message>Access Denied.</Message></Error>`))
+		case r.Method != http.MethodGet:
+			mu.Lock()
+			written = append(written, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusForbidden)
+		case r.URL.Path == "/":
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(listing))
+		case objects[r.URL.Path]:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Cos-Meta-Md5", hex.EncodeToString(checksum[:]))
+			_, _ = w.Write(state)
+		default:
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+		}
+	}))
+	cert, certPEM := selfSignedCertificate(t, fmt.Sprintf("%s.cos.%s.myqcloud.com", cosBucket, cosRegion))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, certPEM, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(written)
+	}
+}
+
+// selfSignedCertificate returns a certificate for host, and the same certificate
+// PEM-encoded so it can be trusted.
+func selfSignedCertificate(t *testing.T, host string) (tls.Certificate, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key},
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// tunnelingProxy is an HTTPS proxy that tunnels every connection to target,
+// whichever host it was made for.
+func tunnelingProxy(t *testing.T, target string) *httptest.Server {
+	t.Helper()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		upstream, err := net.Dial("tcp", target)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer upstream.Close()
+		w.WriteHeader(http.StatusOK)
+		conn, buf, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		done := make(chan struct{})
+		go func() {
+			_, _ = io.Copy(upstream, buf)
+			close(done)
+		}()
+		_, _ = io.Copy(conn, upstream)
+		<-done
+	}))
+	t.Cleanup(proxy.Close)
+	return proxy
+}
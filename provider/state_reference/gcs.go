@@ -0,0 +1,172 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetGcsReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetGcsReference)(nil))
+	_ = (infer.ExplicitDependencies[GetGcsReferenceArgs, StateReferenceOutputs])((*GetGcsReference)(nil))
+)
+
+func (r *GetGcsReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in a Google Cloud Storage bucket.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/gcs#configuration-variables
+type GetGcsReferenceArgs struct {
+	Bucket    string  `pulumi:"bucket"`
+	Prefix    *string `pulumi:"prefix,optional"`
+	Workspace *string `pulumi:"workspace,optional"`
+
+	Credentials *string `pulumi:"credentials,optional" provider:"secret"`
+	AccessToken *string `pulumi:"accessToken,optional" provider:"secret"`
+
+	ImpersonateServiceAccount          *string  `pulumi:"impersonateServiceAccount,optional"`
+	ImpersonateServiceAccountDelegates []string `pulumi:"impersonateServiceAccountDelegates,optional"`
+
+	EncryptionKey    *string `pulumi:"encryptionKey,optional" provider:"secret"`
+	KmsEncryptionKey *string `pulumi:"kmsEncryptionKey,optional"`
+
+	StorageCustomEndpoint *string `pulumi:"storageCustomEndpoint,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetGcsReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Bucket, "The name of the GCS bucket.")
+	a.Describe(&r.Prefix, "The directory inside the bucket holding the state files. The state of a workspace "+
+		"is stored at <prefix>/<workspace>.tfstate.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.Describe(&r.Credentials, "The path to, or the contents of, a Google Cloud service account key file "+
+		"in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment "+
+		"variables, and then to Application Default Credentials, when unset.")
+	a.Describe(&r.AccessToken, "A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls "+
+		"back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.")
+
+	a.Describe(&r.ImpersonateServiceAccount, "The service account to impersonate when reading the state. "+
+		"Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT "+
+		"environment variables when unset.")
+	a.Describe(&r.ImpersonateServiceAccountDelegates, "The delegation chain for impersonating "+
+		"impersonateServiceAccount.")
+
+	a.Describe(&r.EncryptionKey, "The base64-encoded customer-supplied encryption key the state was written "+
+		"with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable "+
+		"when unset.")
+	a.Describe(&r.KmsEncryptionKey, "The Cloud KMS key the state was written with, in the form "+
+		"projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with "+
+		"encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.")
+
+	a.Describe(&r.StorageCustomEndpoint, "A custom endpoint for the Cloud Storage API. Falls back to the "+
+		"GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// GcsDefaults holds provider-wide fallbacks for the credential arguments of
+// getGcsReference. They are also used by the functions taking a backendType of gcs.
+type GcsDefaults struct {
+	Credentials *string `pulumi:"credentials,optional" provider:"secret"`
+	AccessToken *string `pulumi:"accessToken,optional" provider:"secret"`
+
+	ImpersonateServiceAccount          *string  `pulumi:"impersonateServiceAccount,optional"`
+	ImpersonateServiceAccountDelegates []string `pulumi:"impersonateServiceAccountDelegates,optional"`
+
+	StorageCustomEndpoint *string `pulumi:"storageCustomEndpoint,optional"`
+}
+
+func (r *GcsDefaults) Annotate(a infer.Annotator) {
+	a.Describe(&r.Credentials, "The default path to, or the contents of, a Google Cloud service account key "+
+		"file in JSON format.")
+	a.Describe(&r.AccessToken, "The default temporary OAuth 2.0 access token.")
+
+	a.Describe(&r.ImpersonateServiceAccount, "The service account to impersonate by default.")
+	a.Describe(&r.ImpersonateServiceAccountDelegates, "The default delegation chain for impersonating "+
+		"impersonateServiceAccount.")
+
+	a.Describe(&r.StorageCustomEndpoint, "The default custom endpoint for the Cloud Storage API.")
+}
+
+// backendConfig builds the gcs backend configuration the defaults provide, keyed by
+// the backend's attribute names.
+func (r *GcsDefaults) backendConfig() map[string]cty.Value {
+	if r == nil {
+		return nil
+	}
+	return map[string]cty.Value{
+		"credentials":                           ctyStringOrNil(r.Credentials),
+		"access_token":                          ctyStringOrNil(r.AccessToken),
+		"impersonate_service_account":           ctyStringOrNil(r.ImpersonateServiceAccount),
+		"impersonate_service_account_delegates": ctyStringListOrNil(r.ImpersonateServiceAccountDelegates),
+		"storage_custom_endpoint":               ctyStringOrNil(r.StorageCustomEndpoint),
+	}
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetGcsReference) WireDependencies(
+	f infer.FieldSelector, _ *GetGcsReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the gcs backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetGcsReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"bucket":                                cty.StringVal(r.Bucket),
+		"prefix":                                ctyStringOrNil(r.Prefix),
+		"credentials":                           ctyStringOrNil(r.Credentials),
+		"access_token":                          ctyStringOrNil(r.AccessToken),
+		"impersonate_service_account":           ctyStringOrNil(r.ImpersonateServiceAccount),
+		"impersonate_service_account_delegates": ctyStringListOrNil(r.ImpersonateServiceAccountDelegates),
+		"encryption_key":                        ctyStringOrNil(r.EncryptionKey),
+		"kms_encryption_key":                    ctyStringOrNil(r.KmsEncryptionKey),
+		"storage_custom_endpoint":               ctyStringOrNil(r.StorageCustomEndpoint),
+	}
+}
+
+// check validates the arguments as the gcs backend does before reading.
+func (r *GetGcsReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "gcs", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetGcsReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetGcsReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "gcs", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
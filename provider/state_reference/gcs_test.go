@@ -0,0 +1,177 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestGcsBackendConfigMatchesSchema validates backendConfig against the real gcs
+// backend schema, the same way TestAzureRMBackendConfigMatchesSchema does.
+func TestGcsBackendConfigMatchesSchema(t *testing.T) {
+	InitTfBackend()
+	backend := shim.BackendFactory("gcs")()
+
+	tests := []struct {
+		name string
+		args GetGcsReferenceArgs
+	}{
+		{
+			name: "required only",
+			args: GetGcsReferenceArgs{Bucket: gcsBucket},
+		},
+		{
+			name: "all arguments",
+			args: GetGcsReferenceArgs{
+				Bucket:                    gcsBucket,
+				Prefix:                    ptr(gcsPrefix),
+				Credentials:               ptr(`{"type": "service_account"}`),
+				AccessToken:               ptr("access-token"),
+				ImpersonateServiceAccount: ptr("reader@my-project.iam.gserviceaccount.com"),
+				ImpersonateServiceAccountDelegates: []string{
+					"delegate@my-project.iam.gserviceaccount.com",
+				},
+				KmsEncryptionKey:      ptr("projects/p/locations/global/keyRings/r/cryptoKeys/k"),
+				StorageCustomEndpoint: ptr("http://localhost:4443/storage/v1/"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coerced, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(tt.args.backendConfig()))
+			require.NoError(t, err)
+
+			_, diags := backend.PrepareConfig(coerced)
+			require.False(t, diags.HasErrors(), "PrepareConfig: %v", diags.Err())
+		})
+	}
+}
+
+// TestStateReferenceReadGcs reads Terraform state from a GCS-compatible store.
+//
+// A fake-gcs-server container stands in for GCS, and the real tofu CLI writes
+// state through its own gcs backend. We then read that state back through
+// GetGcsReference and confirm the outputs round-trip.
+func TestStateReferenceReadGcs(t *testing.T) {
+	ctx := t.Context()
+	endpoint := startSeededFakeGcs(ctx, t)
+
+	InitTfBackend()
+	resp, err := (&GetGcsReference{}).Invoke(ctx, infer.FunctionRequest[GetGcsReferenceArgs]{
+		Input: GetGcsReferenceArgs{
+			Workspace:             ptr(defaultWorkspace),
+			Bucket:                gcsBucket,
+			Prefix:                ptr(gcsPrefix),
+			AccessToken:           ptr(gcsAccessToken),
+			StorageCustomEndpoint: ptr(endpoint),
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"greeting": greeting,
+		"number":   42,
+	}, resp.Output.Outputs)
+}
+
+const (
+	gcsBucket = "pulumi-terraform-test"
+	gcsPrefix = "env"
+
+	// fake-gcs-server accepts any bearer token. A static token keeps both tofu and
+	// the backend from looking for Application Default Credentials.
+	gcsAccessToken = "fake-token"
+)
+
+// startSeededFakeGcs runs a fake-gcs-server container holding a Terraform state
+// file with known outputs and returns its storage API endpoint.
+func startSeededFakeGcs(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	container, err := testcontainers.Run(ctx, "fsouza/fake-gcs-server:1.52.2",
+		testcontainers.WithCmd("-scheme", "http", "-port", "4443", "-backend", "memory"),
+		testcontainers.WithExposedPorts("4443/tcp"),
+		testcontainers.WithWaitStrategy(wait.ForHTTP("/storage/v1/b").WithPort("4443/tcp")))
+	testcontainers.CleanupContainer(t, container, testcontainers.StopTimeout(0))
+	require.NoError(t, err)
+
+	hostPort, err := container.PortEndpoint(ctx, "4443/tcp", "http")
+	require.NoError(t, err)
+	endpoint := hostPort + "/storage/v1/"
+
+	seedGcsState(ctx, t, endpoint)
+	return endpoint
+}
+
+// seedGcsState creates the state bucket and runs tofu apply against it so the
+// store holds a real Terraform state file with known outputs.
+func seedGcsState(ctx context.Context, t *testing.T, endpoint string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"b?project=test",
+		bytes.NewBufferString(fmt.Sprintf(`{"name": %q}`, gcsBucket)))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	dir := t.TempDir()
+	config := fmt.Sprintf(`terraform {
+  backend "gcs" {
+    bucket                  = %q
+    prefix                  = %q
+    access_token            = %q
+    storage_custom_endpoint = %q
+  }
+}
+
+output "greeting" {
+  value = "hello"
+}
+
+output "number" {
+  value = 42
+}
+`, gcsBucket, gcsPrefix, gcsAccessToken, endpoint)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(config), 0o600))
+
+	tofu := func(args ...string) {
+		cmd := exec.CommandContext(ctx, "tofu", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "tofu %v failed:\n%s", args, out)
+	}
+	tofu("init", "-input=false")
+	tofu("apply", "-auto-approve", "-input=false")
+}
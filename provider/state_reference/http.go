@@ -0,0 +1,126 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetHTTPReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetHTTPReference)(nil))
+	_ = (infer.ExplicitDependencies[GetHTTPReferenceArgs, StateReferenceOutputs])((*GetHTTPReference)(nil))
+)
+
+func (r *GetHTTPReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state served by a REST endpoint through the http backend.")
+	a.SetToken("state", "getHttpReference")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/http#configuration-variables
+//
+// Only the arguments that affect reading state are exposed. Write- and lock-only
+// arguments (such as update_method and lock_address) have no effect on a read and
+// are omitted. The http backend does not support workspaces.
+type GetHTTPReferenceArgs struct {
+	Address  string  `pulumi:"address"`
+	Username *string `pulumi:"username,optional"`
+	Password *string `pulumi:"password,optional" provider:"secret"`
+
+	ClientCaCertificatePem *string `pulumi:"clientCaCertificatePem,optional"`
+	ClientCertificatePem   *string `pulumi:"clientCertificatePem,optional"`
+	ClientPrivateKeyPem    *string `pulumi:"clientPrivateKeyPem,optional" provider:"secret"`
+	SkipCertVerification   *bool   `pulumi:"skipCertVerification,optional"`
+
+	RetryMax     *int `pulumi:"retryMax,optional"`
+	RetryWaitMin *int `pulumi:"retryWaitMin,optional"`
+	RetryWaitMax *int `pulumi:"retryWaitMax,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetHTTPReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Address, "The address of the REST endpoint serving the state.")
+	a.Describe(&r.Username, "The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME "+
+		"environment variable when unset.")
+	a.Describe(&r.Password, "The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD "+
+		"environment variable when unset.")
+
+	a.Describe(&r.ClientCaCertificatePem, "A PEM-encoded CA certificate chain used to verify the server "+
+		"certificate.")
+	a.Describe(&r.ClientCertificatePem, "A PEM-encoded certificate presented to the server for mutual TLS "+
+		"authentication. Requires clientPrivateKeyPem.")
+	a.Describe(&r.ClientPrivateKeyPem, "The PEM-encoded private key of clientCertificatePem.")
+	a.Describe(&r.SkipCertVerification, "Whether to skip verification of the server's TLS certificate.")
+
+	a.Describe(&r.RetryMax, "The number of HTTP request retries. Defaults to 2.")
+	a.Describe(&r.RetryWaitMin, "The minimum time in seconds to wait between HTTP request attempts. "+
+		"Defaults to 1.")
+	a.Describe(&r.RetryWaitMax, "The maximum time in seconds to wait between HTTP request attempts. "+
+		"Defaults to 30.")
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetHTTPReference) WireDependencies(
+	f infer.FieldSelector, _ *GetHTTPReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the http backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetHTTPReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"address":                   cty.StringVal(r.Address),
+		"username":                  ctyStringOrNil(r.Username),
+		"password":                  ctyStringOrNil(r.Password),
+		"client_ca_certificate_pem": ctyStringOrNil(r.ClientCaCertificatePem),
+		"client_certificate_pem":    ctyStringOrNil(r.ClientCertificatePem),
+		"client_private_key_pem":    ctyStringOrNil(r.ClientPrivateKeyPem),
+		"skip_cert_verification":    ctyBoolOrNil(r.SkipCertVerification),
+		"retry_max":                 ctyIntOrNil(r.RetryMax),
+		"retry_wait_min":            ctyIntOrNil(r.RetryWaitMin),
+		"retry_wait_max":            ctyIntOrNil(r.RetryWaitMax),
+	}
+}
+
+// check validates the arguments as the http backend does before reading.
+func (r *GetHTTPReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "http", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetHTTPReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetHTTPReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	ctx, err := prepare(ctx, &req.Input)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "http", defaultWorkspace, req.Input.backendConfig(),
+		req.Input.readOptions(req.Input.OutputArgs))
+}
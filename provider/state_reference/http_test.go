@@ -0,0 +1,143 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	httpUsername = "state-reader"
+	httpPassword = "state-password"
+)
+
+// serveTestState returns a handler serving testdata/test.tfstate to requests
+// authenticated with httpUsername and httpPassword.
+func serveTestState(t *testing.T) http.Handler {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		switch {
+		case !ok:
+			w.WriteHeader(http.StatusUnauthorized)
+		case user != httpUsername || pass != httpPassword:
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method != http.MethodGet:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(state)
+		}
+	})
+}
+
+func TestStateReferenceReadHTTP(t *testing.T) {
+	plain := httptest.NewServer(serveTestState(t))
+	t.Cleanup(plain.Close)
+	tls := httptest.NewTLSServer(serveTestState(t))
+	t.Cleanup(tls.Close)
+	caPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tls.Certificate().Raw}))
+
+	tests := []struct {
+		name        string
+		args        GetHTTPReferenceArgs
+		expectedErr string
+	}{
+		{
+			name: "basic auth",
+			args: GetHTTPReferenceArgs{
+				Address:  plain.URL,
+				Username: ptr(httpUsername),
+				Password: ptr(httpPassword),
+			},
+		},
+		{
+			name: "wrong password",
+			args: GetHTTPReferenceArgs{
+				Address:  plain.URL,
+				Username: ptr(httpUsername),
+				Password: ptr("wrong"),
+			},
+			expectedErr: "HTTP remote state endpoint invalid auth",
+		},
+		{
+			name: "missing credentials",
+			args: GetHTTPReferenceArgs{
+				Address: plain.URL,
+			},
+			expectedErr: "HTTP remote state endpoint requires auth",
+		},
+		{
+			name: "TLS with custom CA",
+			args: GetHTTPReferenceArgs{
+				Address:                tls.URL,
+				Username:               ptr(httpUsername),
+				Password:               ptr(httpPassword),
+				ClientCaCertificatePem: ptr(caPem),
+			},
+		},
+		{
+			name: "TLS skipping verification",
+			args: GetHTTPReferenceArgs{
+				Address:              tls.URL,
+				Username:             ptr(httpUsername),
+				Password:             ptr(httpPassword),
+				SkipCertVerification: ptr(true),
+			},
+		},
+		{
+			name: "TLS with unknown CA",
+			args: GetHTTPReferenceArgs{
+				Address:  tls.URL,
+				Username: ptr(httpUsername),
+				Password: ptr(httpPassword),
+				RetryMax: ptr(0),
+			},
+			expectedErr: "certificate",
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := (&GetHTTPReference{}).Invoke(t.Context(), infer.FunctionRequest[GetHTTPReferenceArgs]{
+				Input: tt.args,
+			})
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+}
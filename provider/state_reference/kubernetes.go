@@ -0,0 +1,186 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetKubernetesReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetKubernetesReference)(nil))
+	_ = (infer.ExplicitDependencies[GetKubernetesReferenceArgs, StateReferenceOutputs])((*GetKubernetesReference)(nil))
+)
+
+func (r *GetKubernetesReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in a Kubernetes secret.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/kubernetes#configuration-variables
+type GetKubernetesReferenceArgs struct {
+	SecretSuffix string  `pulumi:"secretSuffix"`
+	Namespace    *string `pulumi:"namespace,optional"`
+	Workspace    *string `pulumi:"workspace,optional"`
+
+	ConfigPath            *string  `pulumi:"configPath,optional"`
+	ConfigPaths           []string `pulumi:"configPaths,optional"`
+	ConfigContext         *string  `pulumi:"configContext,optional"`
+	ConfigContextAuthInfo *string  `pulumi:"configContextAuthInfo,optional"`
+	ConfigContextCluster  *string  `pulumi:"configContextCluster,optional"`
+	InClusterConfig       *bool    `pulumi:"inClusterConfig,optional"`
+
+	Host                 *string         `pulumi:"host,optional"`
+	Insecure             *bool           `pulumi:"insecure,optional"`
+	ClusterCaCertificate *string         `pulumi:"clusterCaCertificate,optional"`
+	ClientCertificate    *string         `pulumi:"clientCertificate,optional"`
+	ClientKey            *string         `pulumi:"clientKey,optional" provider:"secret"`
+	Token                *string         `pulumi:"token,optional" provider:"secret"`
+	Exec                 *KubernetesExec `pulumi:"exec,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetKubernetesReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.SecretSuffix, "The suffix of the secret holding the state. The secret is named "+
+		"tfstate-<workspace>-<secretSuffix>.")
+	a.Describe(&r.Namespace, "The namespace of the secret holding the state. Falls back to the "+
+		"KUBE_NAMESPACE environment variable, and then to default, when unset.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.Describe(&r.ConfigPath, "The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH "+
+		"environment variable when unset.")
+	a.Describe(&r.ConfigPaths, "A list of paths to kubeconfig files. Ignored when configPath is set. Falls "+
+		"back to the KUBE_CONFIG_PATHS environment variable when unset.")
+	a.Describe(&r.ConfigContext, "The kubeconfig context to use. Falls back to the KUBE_CTX environment "+
+		"variable when unset.")
+	a.Describe(&r.ConfigContextAuthInfo, "The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO "+
+		"environment variable when unset.")
+	a.Describe(&r.ConfigContextCluster, "The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER "+
+		"environment variable when unset.")
+	a.Describe(&r.InClusterConfig, "Whether to authenticate with the service account of the pod the "+
+		"provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.")
+
+	a.Describe(&r.Host, "The URL of the Kubernetes API server, overriding the one from the kubeconfig. The "+
+		"backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment "+
+		"variable when unset.")
+	a.Describe(&r.Insecure, "Whether to skip verification of the API server's TLS certificate. Falls back "+
+		"to the KUBE_INSECURE environment variable when unset.")
+	a.Describe(&r.ClusterCaCertificate, "The PEM-encoded root certificate bundle of the API server. Falls "+
+		"back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.")
+	a.Describe(&r.ClientCertificate, "The PEM-encoded client certificate for TLS authentication. Falls back "+
+		"to the KUBE_CLIENT_CERT_DATA environment variable when unset.")
+	a.Describe(&r.ClientKey, "The PEM-encoded private key of clientCertificate. Falls back to the "+
+		"KUBE_CLIENT_KEY_DATA environment variable when unset.")
+	a.Describe(&r.Token, "A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN "+
+		"environment variable when unset.")
+	a.Describe(&r.Exec, "A credential plugin used to obtain credentials for the API server.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// KubernetesExec configures a client-go credential plugin.
+type KubernetesExec struct {
+	APIVersion string            `pulumi:"apiVersion"`
+	Command    string            `pulumi:"command"`
+	Args       []string          `pulumi:"args,optional"`
+	Env        map[string]string `pulumi:"env,optional"`
+}
+
+func (r *KubernetesExec) Annotate(a infer.Annotator) {
+	a.Describe(&r.APIVersion, "The API version of the ExecCredential the plugin returns, e.g. "+
+		"client.authentication.k8s.io/v1beta1.")
+	a.Describe(&r.Command, "The command to run.")
+	a.Describe(&r.Args, "The arguments passed to the command.")
+	a.Describe(&r.Env, "Additional environment variables for the command.")
+}
+
+// ctyValue returns the exec block as the single-element list the backend schema
+// expects.
+func (r *KubernetesExec) ctyValue() cty.Value {
+	ty := cty.Object(map[string]cty.Type{
+		"api_version": cty.String,
+		"command":     cty.String,
+		"args":        cty.List(cty.String),
+		"env":         cty.Map(cty.String),
+	})
+	if r == nil {
+		return cty.NullVal(cty.List(ty))
+	}
+	return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		"api_version": cty.StringVal(r.APIVersion),
+		"command":     cty.StringVal(r.Command),
+		"args":        ctyStringListOrNil(r.Args),
+		"env":         ctyStringMapOrNil(r.Env),
+	})})
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetKubernetesReference) WireDependencies(
+	f infer.FieldSelector, _ *GetKubernetesReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the kubernetes backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetKubernetesReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"secret_suffix":            cty.StringVal(r.SecretSuffix),
+		"namespace":                ctyStringOrNil(r.Namespace),
+		"config_path":              ctyStringOrNil(r.ConfigPath),
+		"config_paths":             ctyStringListOrNil(r.ConfigPaths),
+		"config_context":           ctyStringOrNil(r.ConfigContext),
+		"config_context_auth_info": ctyStringOrNil(r.ConfigContextAuthInfo),
+		"config_context_cluster":   ctyStringOrNil(r.ConfigContextCluster),
+		"in_cluster_config":        ctyBoolOrNil(r.InClusterConfig),
+		"host":                     ctyStringOrNil(r.Host),
+		"insecure":                 ctyBoolOrNil(r.Insecure),
+		"cluster_ca_certificate":   ctyStringOrNil(r.ClusterCaCertificate),
+		"client_certificate":       ctyStringOrNil(r.ClientCertificate),
+		"client_key":               ctyStringOrNil(r.ClientKey),
+		"token":                    ctyStringOrNil(r.Token),
+		"exec":                     r.Exec.ctyValue(),
+	}
+}
+
+// check validates the arguments as the kubernetes backend does before reading.
+func (r *GetKubernetesReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "kubernetes", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetKubernetesReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetKubernetesReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "kubernetes", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
@@ -0,0 +1,217 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	kubernetesNamespace    = "infra"
+	kubernetesSecretSuffix = "network"
+	kubernetesToken        = "service-account-token"
+)
+
+// fakeKubernetesAPI serves testdata/test.tfstate the way the kubernetes backend
+// stores it: gzipped in the tfstate key of the tfstate-default-<suffix> secret,
+// which is labelled so the backend lists it among the secrets holding states.
+// Only requests carrying kubernetesToken are authorized. The server uses TLS,
+// since client-go only sends kubeconfig credentials over TLS. writes returns the
+// requests made to change anything, which the server refuses.
+func fakeKubernetesAPI(t *testing.T) (server *httptest.Server, writes func() []string) {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write(state)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	secret := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]any{
+			"name":      "tfstate-default-" + kubernetesSecretSuffix,
+			"namespace": kubernetesNamespace,
+			"labels": map[string]any{
+				"tfstate":             "true",
+				"tfstateWorkspace":    defaultWorkspace,
+				"tfstateSecretSuffix": kubernetesSecretSuffix,
+			},
+		},
+		"data": map[string]any{
+			"tfstate": base64.StdEncoding.EncodeToString(compressed.Bytes()),
+		},
+	}
+	secretJSON, err := json.Marshal(secret)
+	require.NoError(t, err)
+	secretsJSON, err := json.Marshal(map[string]any{
+		"apiVersion": "v1",
+		"kind":       "SecretList",
+		"items":      []any{secret},
+	})
+	require.NoError(t, err)
+
+	secretsPath := fmt.Sprintf("/api/v1/namespaces/%s/secrets", kubernetesNamespace)
+	secretPath := secretsPath + "/tfstate-default-" + kubernetesSecretSuffix
+	var mu sync.Mutex
+	var written []string
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("Authorization") != "Bearer "+kubernetesToken:
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.Method != http.MethodGet:
+			mu.Lock()
+			written = append(written, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodGet && r.URL.Path == secretsPath:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(secretsJSON)
+		case r.Method == http.MethodGet && r.URL.Path == secretPath:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(secretJSON)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(written)
+	}
+}
+
+func TestStateReferenceReadKubernetes(t *testing.T) {
+	server, writes := fakeKubernetesAPI(t)
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(kubeconfig, fmt.Appendf(nil, `apiVersion: v1
+kind: Config
+clusters:
+- name: unreachable
+  cluster:
+    server: https://unreachable.invalid
+- name: fake
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+users:
+- name: reader
+  user:
+    token: %s
+contexts:
+- name: other
+  context:
+    cluster: fake
+    user: nobody
+- name: elsewhere
+  context:
+    cluster: unreachable
+    user: reader
+- name: fake
+  context:
+    cluster: fake
+    user: reader
+current-context: other
+`, server.URL, base64.StdEncoding.EncodeToString(caPem), kubernetesToken), 0o600))
+
+	tests := []struct {
+		name string
+		args GetKubernetesReferenceArgs
+	}{
+		{
+			name: "kubeconfig context",
+			args: GetKubernetesReferenceArgs{
+				ConfigPath:    ptr(kubeconfig),
+				ConfigContext: ptr("fake"),
+			},
+		},
+		{
+			name: "explicit token overrides kubeconfig user",
+			args: GetKubernetesReferenceArgs{
+				ConfigPath: ptr(kubeconfig),
+				Token:      ptr(kubernetesToken),
+			},
+		},
+		{
+			name: "explicit host and CA override kubeconfig cluster",
+			args: GetKubernetesReferenceArgs{
+				ConfigPath:           ptr(kubeconfig),
+				ConfigContext:        ptr("elsewhere"),
+				Host:                 ptr(server.URL),
+				ClusterCaCertificate: ptr(string(caPem)),
+			},
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Workspace = ptr(defaultWorkspace)
+			tt.args.SecretSuffix = kubernetesSecretSuffix
+			tt.args.Namespace = ptr(kubernetesNamespace)
+
+			resp, err := (&GetKubernetesReference{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetKubernetesReferenceArgs]{Input: tt.args})
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+
+	// Opening the default workspace with the kubernetes backend writes a secret for
+	// it when there is none, so its secret must be found before it is read.
+	t.Run("missing secret", func(t *testing.T) {
+		_, err := (&GetKubernetesReference{}).Invoke(t.Context(),
+			infer.FunctionRequest[GetKubernetesReferenceArgs]{Input: GetKubernetesReferenceArgs{
+				SecretSuffix: "missing",
+				Namespace:    ptr(kubernetesNamespace),
+				Workspace:    ptr(defaultWorkspace),
+				ConfigPath:   ptr(kubeconfig),
+				Token:        ptr(kubernetesToken),
+			}})
+		var readErr *shim.ReadError
+		require.ErrorAs(t, err, &readErr)
+		assert.Equal(t, shim.ReasonEmptyState, readErr.Reason)
+
+		assert.Empty(t, writes())
+	})
+}
@@ -17,9 +17,9 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform/shim"
 	"github.com/zclconf/go-cty/cty"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -38,6 +38,9 @@ func (r *GetLocalReference) Annotate(a infer.Annotator) {
 type GetLocalReferenceArgs struct {
 	Path         *string `pulumi:"path,optional"`
 	WorkspaceDir *string `pulumi:"workspaceDir,optional"`
+
+	OutputArgs
+	ReadArgs
 }
 
 func (r *GetLocalReferenceArgs) Annotate(a infer.Annotator) {
@@ -46,14 +49,29 @@ func (r *GetLocalReferenceArgs) Annotate(a infer.Annotator) {
 	a.Describe(&r.WorkspaceDir, `The path to non-default workspaces.`)
 }
 
+// backendConfig builds the local backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetLocalReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		localPathAttribute: ctyStringOrNil(r.Path),
+		"workspace_dir":    ctyStringOrNil(r.WorkspaceDir),
+	}
+}
+
+// check validates the arguments as the local backend does before reading.
+func (r *GetLocalReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "local", r.backendConfig(), r.Env, argumentPath)
+}
+
 func (r *GetLocalReference) Invoke(
 	ctx context.Context,
 	req infer.FunctionRequest[GetLocalReferenceArgs],
 ) (infer.FunctionResponse[StateReferenceOutputs], error) {
-	results, err := shim.StateReferenceRead(ctx, "local", "", map[string]cty.Value{
-		localPathAttribute: ctyStringOrNil(req.Input.Path),
-		"workspace_dir":    ctyStringOrNil(req.Input.WorkspaceDir),
-	})
+	ctx, err := prepare(ctx, &req.Input)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
 
-	return infer.FunctionResponse[StateReferenceOutputs]{Output: StateReferenceOutputs{results}}, err
+	return readStateReference(ctx, "local", "", req.Input.backendConfig(),
+		req.Input.readOptions(req.Input.OutputArgs))
 }
@@ -0,0 +1,152 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetOssReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetOssReference)(nil))
+	_ = (infer.ExplicitDependencies[GetOssReferenceArgs, StateReferenceOutputs])((*GetOssReference)(nil))
+)
+
+func (r *GetOssReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/oss#configuration-variables
+//
+// Only the arguments that affect reading state are exposed. Write- and lock-only
+// arguments (such as acl and tablestore_table) have no effect on a read and are omitted.
+type GetOssReferenceArgs struct {
+	Bucket    string  `pulumi:"bucket"`
+	Prefix    *string `pulumi:"prefix,optional"`
+	Key       *string `pulumi:"key,optional"`
+	Region    *string `pulumi:"region,optional"`
+	Workspace *string `pulumi:"workspace,optional"`
+
+	Endpoint    *string `pulumi:"endpoint,optional"`
+	StsEndpoint *string `pulumi:"stsEndpoint,optional"`
+
+	AccessKey             *string `pulumi:"accessKey,optional" provider:"secret"`
+	SecretKey             *string `pulumi:"secretKey,optional" provider:"secret"`
+	SecurityToken         *string `pulumi:"securityToken,optional" provider:"secret"`
+	EcsRoleName           *string `pulumi:"ecsRoleName,optional"`
+	Profile               *string `pulumi:"profile,optional"`
+	SharedCredentialsFile *string `pulumi:"sharedCredentialsFile,optional"`
+
+	RoleArn                     *string `pulumi:"roleArn,optional"`
+	SessionName                 *string `pulumi:"sessionName,optional"`
+	AssumeRolePolicy            *string `pulumi:"assumeRolePolicy,optional"`
+	AssumeRoleSessionExpiration *int    `pulumi:"assumeRoleSessionExpiration,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetOssReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Bucket, "The name of the OSS bucket.")
+	a.Describe(&r.Prefix, "The directory in the bucket holding the state. Defaults to env:.")
+	a.Describe(&r.Key, "The name of the state file. The state path is <prefix>/<key> for the default "+
+		"workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.")
+	a.Describe(&r.Region, "The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment "+
+		"variable when unset.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.Describe(&r.Endpoint, "A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT "+
+		"environment variable when unset.")
+	a.Describe(&r.StsEndpoint, "A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT "+
+		"environment variable when unset.")
+
+	a.Describe(&r.AccessKey, "Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment "+
+		"variable when unset.")
+	a.Describe(&r.SecretKey, "Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment "+
+		"variable when unset.")
+	a.Describe(&r.SecurityToken, "The security token of temporary credentials. Falls back to the "+
+		"ALICLOUD_SECURITY_TOKEN environment variable when unset.")
+	a.Describe(&r.EcsRoleName, "The RAM role attached to the ECS instance to read credentials from, used "+
+		"when no access key is configured.")
+	a.Describe(&r.Profile, "The profile name as set in the shared credentials file.")
+	a.Describe(&r.SharedCredentialsFile, "Path to a shared credentials file.")
+
+	a.Describe(&r.RoleArn, "The ARN of a RAM role to be assumed in order to read the state.")
+	a.Describe(&r.SessionName, "The session name to use when assuming the role.")
+	a.Describe(&r.AssumeRolePolicy, "A policy further restricting the permissions of the assumed role.")
+	a.Describe(&r.AssumeRoleSessionExpiration, "The duration, in seconds, of the assume role session, "+
+		"between 900 and 3600.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the credentials (when provided) are always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetOssReference) WireDependencies(
+	f infer.FieldSelector, _ *GetOssReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the oss backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetOssReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"bucket":                         cty.StringVal(r.Bucket),
+		"prefix":                         ctyStringOrNil(r.Prefix),
+		"key":                            ctyStringOrNil(r.Key),
+		"region":                         ctyStringOrNil(r.Region),
+		"endpoint":                       ctyStringOrNil(r.Endpoint),
+		"sts_endpoint":                   ctyStringOrNil(r.StsEndpoint),
+		"access_key":                     ctyStringOrNil(r.AccessKey),
+		"secret_key":                     ctyStringOrNil(r.SecretKey),
+		"security_token":                 ctyStringOrNil(r.SecurityToken),
+		"ecs_role_name":                  ctyStringOrNil(r.EcsRoleName),
+		"profile":                        ctyStringOrNil(r.Profile),
+		"shared_credentials_file":        ctyStringOrNil(r.SharedCredentialsFile),
+		"assume_role_role_arn":           ctyStringOrNil(r.RoleArn),
+		"assume_role_session_name":       ctyStringOrNil(r.SessionName),
+		"assume_role_policy":             ctyStringOrNil(r.AssumeRolePolicy),
+		"assume_role_session_expiration": ctyIntOrNil(r.AssumeRoleSessionExpiration),
+	}
+}
+
+// check validates the arguments as the oss backend does before reading.
+func (r *GetOssReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "oss", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetOssReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetOssReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "oss", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
@@ -0,0 +1,217 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	ossBucket    = "terraform-state"
+	ossAccessKey = "oss-access-key"
+	ossWorkspace = "staging"
+)
+
+// TestOssBackendConfigMatchesSchema validates backendConfig against the real oss
+// backend schema, the same way TestAzureRMBackendConfigMatchesSchema does.
+func TestOssBackendConfigMatchesSchema(t *testing.T) {
+	InitTfBackend()
+	backend := shim.BackendFactory("oss")()
+
+	tests := []struct {
+		name string
+		args GetOssReferenceArgs
+	}{
+		{
+			name: "required only",
+			args: GetOssReferenceArgs{Bucket: ossBucket},
+		},
+		{
+			name: "all arguments",
+			args: GetOssReferenceArgs{
+				Bucket:                      ossBucket,
+				Prefix:                      ptr("states"),
+				Key:                         ptr("network.tfstate"),
+				Region:                      ptr("cn-hangzhou"),
+				Endpoint:                    ptr("oss-cn-hangzhou.aliyuncs.com"),
+				StsEndpoint:                 ptr("sts.aliyuncs.com"),
+				AccessKey:                   ptr(ossAccessKey),
+				SecretKey:                   ptr("oss-secret-key"),
+				SecurityToken:               ptr("security-token"),
+				EcsRoleName:                 ptr("reader"),
+				Profile:                     ptr("default"),
+				SharedCredentialsFile:       ptr("/home/user/.aliyun/config.json"),
+				RoleArn:                     ptr("acs:ram::100000000001:role/reader"),
+				SessionName:                 ptr("pulumi"),
+				AssumeRolePolicy:            ptr(`{"Version": "1"}`),
+				AssumeRoleSessionExpiration: ptr(900),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coerced, err := backend.ConfigSchema().CoerceValue(cty.ObjectVal(tt.args.backendConfig()))
+			require.NoError(t, err)
+
+			_, diags := backend.PrepareConfig(coerced)
+			require.False(t, diags.HasErrors(), "PrepareConfig: %v", diags.Err())
+		})
+	}
+}
+
+// TestStateReferenceReadOss reads Terraform state from a fake OSS API server.
+//
+// The OSS API is not S3-compatible enough for MinIO to stand in for it, since
+// requests are signed with OSS's own scheme, so a small fake serves the handful of
+// requests a read makes instead.
+func TestStateReferenceReadOss(t *testing.T) {
+	server, writes := fakeOssAPI(t)
+
+	tests := []struct {
+		name    string
+		args    GetOssReferenceArgs
+		wantErr string
+	}{
+		{
+			name: "default workspace",
+			args: GetOssReferenceArgs{
+				Workspace: ptr(defaultWorkspace),
+				AccessKey: ptr(ossAccessKey),
+			},
+		},
+		{
+			name: "named workspace",
+			args: GetOssReferenceArgs{
+				Workspace: ptr(ossWorkspace),
+				AccessKey: ptr(ossAccessKey),
+			},
+		},
+		{
+			name: "missing workspace",
+			args: GetOssReferenceArgs{
+				Workspace: ptr("missing"),
+				AccessKey: ptr(ossAccessKey),
+			},
+			wantErr: string(shim.ReasonWorkspaceNotFound),
+		},
+		{
+			name: "wrong access key",
+			args: GetOssReferenceArgs{
+				Workspace: ptr(defaultWorkspace),
+				AccessKey: ptr("wrong-access-key"),
+			},
+			wantErr: "AccessDenied",
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.Bucket = ossBucket
+			tt.args.Endpoint = ptr(server.URL)
+			tt.args.SecretKey = ptr("oss-secret-key")
+
+			resp, err := (&GetOssReference{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetOssReferenceArgs]{Input: tt.args})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, map[string]any{
+				"greeting": "hello",
+				"count":    42,
+			}, resp.Output.Outputs)
+		})
+	}
+
+	// The oss backend writes an empty state for the workspaces it opens that do not
+	// exist, which reading must never do.
+	assert.Empty(t, writes())
+}
+
+// fakeOssAPI serves testdata/test.tfstate as the state of both the default
+// workspace and ossWorkspace, under the default env: prefix. Signatures are not
+// checked, but only requests signed with ossAccessKey are authorized. writes returns
+// the requests made to change anything, which the server refuses.
+func fakeOssAPI(t *testing.T) (server *httptest.Server, writes func() []string) {
+	t.Helper()
+
+	state, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+
+	objects := map[string]bool{
+		"/" + ossBucket + "/env:/terraform.tfstate":                      true,
+		"/" + ossBucket + "/env:/" + ossWorkspace + "/terraform.tfstate": true,
+	}
+	listing := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult>
+  <Name>%s</Name>
+  <Prefix>env:/</Prefix>
+  <MaxKeys>1000</MaxKeys>
+  <IsTruncated>false</IsTruncated>
+  <Contents><Key>env:/terraform.tfstate</Key></Contents>
+  <Contents><Key>env:/%s/terraform.tfstate</Key></Contents>
+</ListBucketResult>`, ossBucket, ossWorkspace)
+
+	var mu sync.Mutex
+	var written []string
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case !strings.HasPrefix(r.Header.Get("Authorization"), "OSS "+ossAccessKey+":"):
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>AccessDenied</Code><Message>The OSS Access Key Id is invalid.</Message></Error>`))
+		case r.Method != http.MethodGet && r.Method != http.MethodHead:
+			mu.Lock()
+			written = append(written, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodGet && r.URL.Path == "/"+ossBucket+"/":
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(listing))
+		case r.Method == http.MethodHead && objects[r.URL.Path]:
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodGet && objects[r.URL.Path]:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(state)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(written)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/shim"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetOutput struct{}
+
+var (
+	_ = (infer.Annotated)((*GetOutput)(nil))
+	_ = (infer.ExplicitDependencies[GetOutputArgs, GetOutputResult])((*GetOutput)(nil))
+)
+
+func (r *GetOutput) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Read a single output from Terraform state, stored in any backend Terraform supports. "+
+		"The backend is configured as in getBackendReference. Outputs Terraform marks as sensitive are "+
+		"returned as secrets.")
+}
+
+type GetOutputArgs struct {
+	BackendArgs
+	WorkspaceArgs
+
+	Name    string `pulumi:"name"`
+	Default any    `pulumi:"default,optional"`
+	ReadArgs
+}
+
+func (r *GetOutputArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Name, "The name of the output to read.")
+	a.Describe(&r.Default, "The value to return when the state has no output named name. When unset, a "+
+		"missing output is an error.")
+}
+
+type GetOutputResult struct {
+	Value any `pulumi:"value,optional"`
+}
+
+var _ = (infer.Annotated)((*GetOutputResult)(nil))
+
+func (r *GetOutputResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Value, "The value of the output. "+largeIntegers)
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the secretConfig (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetOutput) WireDependencies(f infer.FieldSelector, _ *GetOutputArgs, state *GetOutputResult) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// check validates the backend configuration as the backend does before reading.
+func (r *GetOutputArgs) check(ctx context.Context) []p.CheckFailure {
+	return r.BackendArgs.check(ctx, r.Env)
+}
+
+func (r *GetOutput) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetOutputArgs],
+) (infer.FunctionResponse[GetOutputResult], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[GetOutputResult]{}, err
+	}
+
+	config, err := args.merge()
+	if err != nil {
+		return infer.FunctionResponse[GetOutputResult]{}, err
+	}
+	resp, err := readStateReference(ctx, args.BackendType, *args.Workspace, config,
+		args.readOptions(OutputArgs{Outputs: []string{args.Name}}))
+	if err != nil {
+		return infer.FunctionResponse[GetOutputResult]{}, err
+	}
+
+	value, ok := resp.Output.Outputs[args.Name]
+	switch {
+	case ok:
+	case args.Default != nil:
+		value = args.Default
+	default:
+		return infer.FunctionResponse[GetOutputResult]{}, &shim.ReadError{
+			BackendType: args.BackendType,
+			Workspace:   *args.Workspace,
+			Stage:       shim.StageOutputs,
+			Reason:      shim.ReasonOutputNotFound,
+			Err:         fmt.Errorf("output %q not found", args.Name),
+		}
+	}
+	return infer.FunctionResponse[GetOutputResult]{Output: GetOutputResult{Value: value}}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+func TestGetOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		fallback any
+		want     any
+		wantCode codes.Code
+	}{
+		{name: "plain output", output: "endpoint", want: "db.internal:5432"},
+		{name: "sensitive output", output: "password", want: secretValue("hunter2")},
+		{name: "existing output ignores default", output: "endpoint", fallback: "unused", want: "db.internal:5432"},
+		{name: "missing output with default", output: "vpc_id", fallback: "vpc-default", want: "vpc-default"},
+		{name: "missing output", output: "vpc_id", wantCode: codes.NotFound},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := (&GetOutput{}).Invoke(t.Context(), infer.FunctionRequest[GetOutputArgs]{
+				Input: GetOutputArgs{
+					BackendArgs: BackendArgs{
+						BackendType: "local",
+						Config:      map[string]any{localPathAttribute: "testdata/sensitive.tfstate"},
+					},
+					WorkspaceArgs: WorkspaceArgs{Workspace: ptr(defaultWorkspace)},
+					Name:          tt.output,
+					Default:       tt.fallback,
+				},
+			})
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				assert.ErrorContains(t, err, `output "vpc_id" not found`)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.Output.Value)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/zclconf/go-cty/cty"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetPgReference struct{}
+
+var (
+	_ = (infer.Annotated)((*GetPgReference)(nil))
+	_ = (infer.ExplicitDependencies[GetPgReferenceArgs, StateReferenceOutputs])((*GetPgReference)(nil))
+)
+
+func (r *GetPgReference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access state stored in a PostgreSQL database.")
+}
+
+// Taken from https://developer.hashicorp.com/terraform/language/backend/pg#configuration-variables
+//
+// Only the arguments that affect reading state are exposed. The skip_*_creation
+// arguments are always set, since a read must never create the schema, table or
+// index holding the state.
+type GetPgReferenceArgs struct {
+	ConnStr    *string `pulumi:"connStr,optional" provider:"secret"`
+	SchemaName *string `pulumi:"schemaName,optional"`
+	Workspace  *string `pulumi:"workspace,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetPgReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.ConnStr, "The Postgres connection string, as a postgres:// URL. Falls back to the "+
+		"PG_CONN_STR environment variable when unset.")
+	a.Describe(&r.SchemaName, "The name of the Postgres schema holding the states table. Falls back to the "+
+		"PG_SCHEMA_NAME environment variable, and then to terraform_remote_state, when unset.")
+	a.Describe(&r.Workspace, "The Terraform workspace to read state from.")
+
+	a.SetDefault(&r.Workspace, defaultWorkspace)
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the connection string (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetPgReference) WireDependencies(
+	f infer.FieldSelector, _ *GetPgReferenceArgs, state *StateReferenceOutputs,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// backendConfig builds the pg backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetPgReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"conn_str":             ctyStringOrNil(r.ConnStr),
+		"schema_name":          ctyStringOrNil(r.SchemaName),
+		"skip_schema_creation": cty.True,
+		"skip_table_creation":  cty.True,
+		"skip_index_creation":  cty.True,
+	}
+}
+
+// check validates the arguments as the pg backend does before reading.
+func (r *GetPgReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "pg", r.backendConfig(), r.Env, argumentPath)
+}
+
+func (r *GetPgReference) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetPgReferenceArgs],
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	return readStateReference(ctx, "pg", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
+}
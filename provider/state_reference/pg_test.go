@@ -0,0 +1,175 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestStateReferenceReadPg reads Terraform state from a Postgres database.
+//
+// A Postgres container holds the state, and the real tofu CLI writes it through
+// its own pg backend. We then read that state back through GetPgReference.
+func TestStateReferenceReadPg(t *testing.T) {
+	ctx := t.Context()
+	connStr := startSeededPostgres(ctx, t)
+
+	InitTfBackend()
+
+	t.Run("seeded schema", func(t *testing.T) {
+		resp, err := (&GetPgReference{}).Invoke(ctx, infer.FunctionRequest[GetPgReferenceArgs]{
+			Input: GetPgReferenceArgs{
+				Workspace:  ptr(defaultWorkspace),
+				ConnStr:    ptr(connStr),
+				SchemaName: ptr(pgSchemaName),
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"greeting": greeting,
+			"number":   42,
+		}, resp.Output.Outputs)
+	})
+
+	// Reading must never create the schema holding the state, so pointing at a
+	// schema that does not exist is an error rather than an empty state.
+	t.Run("missing schema", func(t *testing.T) {
+		_, err := (&GetPgReference{}).Invoke(ctx, infer.FunctionRequest[GetPgReferenceArgs]{
+			Input: GetPgReferenceArgs{
+				Workspace:  ptr(defaultWorkspace),
+				ConnStr:    ptr(connStr),
+				SchemaName: ptr("does_not_exist"),
+			},
+		})
+		require.ErrorContains(t, err, "does not exist")
+	})
+
+	// Opening a workspace with the pg backend creates it when it does not exist, so
+	// a workspace must be found among those listed before it is read.
+	t.Run("missing workspace", func(t *testing.T) {
+		before := pgStates(ctx, t, connStr)
+
+		_, err := (&GetPgReference{}).Invoke(ctx, infer.FunctionRequest[GetPgReferenceArgs]{
+			Input: GetPgReferenceArgs{
+				Workspace:  ptr("missing"),
+				ConnStr:    ptr(connStr),
+				SchemaName: ptr(pgSchemaName),
+			},
+		})
+		var readErr *shim.ReadError
+		require.ErrorAs(t, err, &readErr)
+		assert.Equal(t, shim.ReasonWorkspaceNotFound, readErr.Reason)
+
+		assert.Equal(t, before, pgStates(ctx, t, connStr))
+	})
+}
+
+// pgStates returns the rows of the table the pg backend keeps states in, keyed by
+// workspace.
+func pgStates(ctx context.Context, t *testing.T, connStr string) map[string]string {
+	t.Helper()
+
+	db, err := sql.Open("postgres", connStr)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SELECT name, data FROM %s.states", pgSchemaName))
+	require.NoError(t, err)
+	defer rows.Close()
+
+	states := map[string]string{}
+	for rows.Next() {
+		var name, data string
+		require.NoError(t, rows.Scan(&name, &data))
+		states[name] = data
+	}
+	require.NoError(t, rows.Err())
+	return states
+}
+
+const (
+	pgPassword   = "postgres"
+	pgSchemaName = "terraform_state"
+)
+
+// startSeededPostgres runs a Postgres container holding a Terraform state with
+// known outputs and returns its connection string.
+func startSeededPostgres(ctx context.Context, t *testing.T) string {
+	t.Helper()
+
+	container, err := testcontainers.Run(ctx, "postgres:16-alpine",
+		testcontainers.WithEnv(map[string]string{"POSTGRES_PASSWORD": pgPassword}),
+		testcontainers.WithExposedPorts("5432/tcp"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2)))
+	testcontainers.CleanupContainer(t, container, testcontainers.StopTimeout(0))
+	require.NoError(t, err)
+
+	hostPort, err := container.PortEndpoint(ctx, "5432/tcp", "")
+	require.NoError(t, err)
+	connStr := fmt.Sprintf("postgres://postgres:%s@%s/postgres?sslmode=disable", pgPassword, hostPort)
+
+	seedPgState(ctx, t, connStr)
+	return connStr
+}
+
+// seedPgState runs tofu apply against the database so it holds a real Terraform
+// state with known outputs.
+func seedPgState(ctx context.Context, t *testing.T, connStr string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	config := fmt.Sprintf(`terraform {
+  backend "pg" {
+    conn_str    = %q
+    schema_name = %q
+  }
+}
+
+output "greeting" {
+  value = "hello"
+}
+
+output "number" {
+  value = 42
+}
+`, connStr, pgSchemaName)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(config), 0o600))
+
+	tofu := func(args ...string) {
+		cmd := exec.CommandContext(ctx, "tofu", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "tofu %v failed:\n%s", args, out)
+	}
+	tofu("init", "-input=false")
+	tofu("apply", "-auto-approve", "-input=false")
+}
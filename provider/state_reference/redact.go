@@ -0,0 +1,106 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform/shim"
+)
+
+// RedactSecrets scrubs the secrets of the call serving ctx, and common credential
+// formats, from s.
+func RedactSecrets(ctx context.Context, s string) string { return shim.RedactorFrom(ctx).Redact(s) }
+
+// WithSecretRedaction returns a copy of ctx carrying a redactor for the secrets of a
+// single call, which withSecrets adds to and which is discarded with ctx.
+func WithSecretRedaction(ctx context.Context) context.Context {
+	return shim.WithRedactor(ctx, &shim.Redactor{})
+}
+
+// withSecrets records the values of the fields of args tagged provider:"secret", and
+// those among the defaults of the provider, so they are redacted from Terraform's log
+// messages and the errors of reads made under the returned context. It adds them to
+// the redactor of ctx, or to a new one when ctx carries none.
+func withSecrets(ctx context.Context, args any) context.Context {
+	r := shim.RedactorFrom(ctx)
+	if r == nil {
+		r = &shim.Redactor{}
+		ctx = shim.WithRedactor(ctx, r)
+	}
+	r.Add(secretValues(reflect.ValueOf(args), false)...)
+	r.Add(secretValues(reflect.ValueOf(providerConfig(ctx)), false)...)
+	return ctx
+}
+
+// credentialKey matches the names of environment variables that hold credentials,
+// such as AWS_SECRET_ACCESS_KEY, ARM_SAS_TOKEN or PG_CONN_STR.
+var credentialKey = regexp.MustCompile(`(?i)secret|token|passw(or)?d|credential|auth|sas|private|conn_str|_key(_|$)`)
+
+// secretValues returns the strings held by v that are secret, either because secret
+// is set or because they are held by a field tagged provider:"secret". Fields also
+// tagged redact:"credentials" hold maps of which only the entries whose key names a
+// credential are secret, as with environment variables.
+func secretValues(v reflect.Value, secret bool) []string {
+	var values []string
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			values = secretValues(v.Elem(), secret)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			tagged := slices.Contains(strings.Split(field.Tag.Get("provider"), ","), "secret")
+			if tagged && field.Tag.Get("redact") == "credentials" {
+				values = append(values, credentialValues(v.Field(i))...)
+				continue
+			}
+			values = append(values, secretValues(v.Field(i), secret || tagged)...)
+		}
+	case reflect.Map:
+		for it := v.MapRange(); it.Next(); {
+			values = append(values, secretValues(it.Value(), secret)...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			values = append(values, secretValues(v.Index(i), secret)...)
+		}
+	case reflect.String:
+		if secret {
+			values = append(values, v.String())
+		}
+	}
+	return values
+}
+
+// credentialValues returns the values of the entries of the map v whose key names a
+// credential.
+func credentialValues(v reflect.Value) []string {
+	var values []string
+	for it := v.MapRange(); it.Next(); {
+		if credentialKey.MatchString(it.Key().String()) {
+			values = append(values, secretValues(it.Value(), true)...)
+		}
+	}
+	return values
+}
@@ -0,0 +1,235 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// Reference is a resource holding a snapshot of Terraform state.
+//
+// Unlike the state reference functions, it keeps the serial and lineage of the
+// snapshot it read in the Pulumi state, so a preview can show that the upstream
+// Terraform state has changed since the last update.
+type Reference struct{}
+
+var (
+	_ = (infer.Annotated)((*Reference)(nil))
+	_ = (infer.CustomCheck[ReferenceArgs])((*Reference)(nil))
+	_ = (infer.CustomCreate[ReferenceArgs, ReferenceState])((*Reference)(nil))
+	_ = (infer.CustomDiff[ReferenceArgs, ReferenceState])((*Reference)(nil))
+	_ = (infer.CustomUpdate[ReferenceArgs, ReferenceState])((*Reference)(nil))
+	_ = (infer.CustomRead[ReferenceArgs, ReferenceState])((*Reference)(nil))
+)
+
+func (r *Reference) Annotate(a infer.Annotator) {
+	a.Describe(&r, "A snapshot of Terraform state, stored in any backend Terraform supports. The backend "+
+		"is configured as in getBackendReference.\n\n"+
+		"The outputs and the serial of the snapshot are kept in the Pulumi state. When the upstream "+
+		"Terraform state changes, the next preview shows an update of serial, and dependents see the new "+
+		"outputs once it is applied.")
+}
+
+type ReferenceArgs struct {
+	BackendArgs
+	WorkspaceArgs
+
+	RequiredOutputs []string `pulumi:"requiredOutputs,optional"`
+	ReadArgs
+}
+
+func (r *ReferenceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.RequiredOutputs, "The names of the outputs the state must contain. Reading the state fails, "+
+		"naming the missing outputs, when any of them is absent.")
+}
+
+type ReferenceState struct {
+	ReferenceArgs
+
+	Outputs            map[string]any `pulumi:"outputs"`
+	Serial             int            `pulumi:"serial"`
+	Lineage            string         `pulumi:"lineage"`
+	TerraformVersion   *string        `pulumi:"terraformVersion,optional"`
+	StateFormatVersion *int           `pulumi:"stateFormatVersion,optional"`
+}
+
+func (r *ReferenceState) Annotate(a infer.Annotator) {
+	a.Describe(&r.Outputs, "The outputs of the snapshot. Outputs Terraform marks as sensitive are secrets. "+
+		largeIntegers)
+	a.Describe(&r.Serial, "The serial of the snapshot. Terraform increments it on every change to the state.")
+	a.Describe(&r.Lineage, "The lineage of the snapshot, assigned when the state was first created.")
+	a.Describe(&r.TerraformVersion, "The version of Terraform that wrote the snapshot. Unset when the "+
+		"backend does not record it.")
+	a.Describe(&r.StateFormatVersion, "The version of the state file format the snapshot is stored in. Unset "+
+		"when the backend does not expose the raw snapshot, as with the cloud backend.")
+}
+
+// check validates the backend configuration as the backend does before reading.
+func (r *ReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return r.BackendArgs.check(ctx, r.Env)
+}
+
+// read reads the current snapshot of the state r refers to.
+func (r ReferenceArgs) read(ctx context.Context) (ReferenceState, error) {
+	ctx = withSecrets(ctx, r)
+	config, err := r.merge()
+	if err != nil {
+		return ReferenceState{}, err
+	}
+	// Inputs given to Read are not checked, so they lack the default workspace.
+	workspace := defaultWorkspace
+	if r.Workspace != nil {
+		workspace = *r.Workspace
+	}
+	resp, err := readStateReference(ctx, r.BackendType, workspace, config,
+		r.readOptions(OutputArgs{RequiredOutputs: r.RequiredOutputs}))
+	if err != nil {
+		return ReferenceState{}, err
+	}
+
+	return ReferenceState{
+		ReferenceArgs:      r,
+		Outputs:            resp.Output.Outputs,
+		Serial:             resp.Output.Serial,
+		Lineage:            resp.Output.Lineage,
+		TerraformVersion:   resp.Output.TerraformVersion,
+		StateFormatVersion: resp.Output.StateFormatVersion,
+	}, nil
+}
+
+// Check validates the backend configuration during preview, so an invalid argument is
+// reported against the property it is set on instead of failing the read. Inputs that
+// are not known yet are left for the read to check.
+func (r *Reference) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ReferenceArgs], error) {
+	inputs, failures, err := infer.DefaultCheck[ReferenceArgs](ctx, req.NewInputs)
+	if err != nil || len(failures) > 0 || property.New(req.NewInputs).HasComputed() {
+		return infer.CheckResponse[ReferenceArgs]{Inputs: inputs, Failures: failures}, err
+	}
+
+	ctx = withSecrets(ctx, inputs)
+	return infer.CheckResponse[ReferenceArgs]{Inputs: inputs, Failures: inputs.check(ctx)}, nil
+}
+
+func (r *Reference) Create(
+	ctx context.Context, req infer.CreateRequest[ReferenceArgs],
+) (infer.CreateResponse[ReferenceState], error) {
+	// The inputs may not be known yet during a preview, so we leave the outputs for
+	// infer to mark as computed.
+	if req.DryRun {
+		return infer.CreateResponse[ReferenceState]{
+			ID:     req.Name,
+			Output: ReferenceState{ReferenceArgs: req.Inputs},
+		}, nil
+	}
+
+	state, err := req.Inputs.read(ctx)
+	if err != nil {
+		return infer.CreateResponse[ReferenceState]{}, err
+	}
+	return infer.CreateResponse[ReferenceState]{ID: req.Name, Output: state}, nil
+}
+
+// Diff reports changed inputs as updates. When the inputs are unchanged, it reads the
+// upstream state and reports an update when its serial or lineage has moved on.
+func (r *Reference) Diff(
+	ctx context.Context, req infer.DiffRequest[ReferenceArgs, ReferenceState],
+) (infer.DiffResponse, error) {
+	olds, news := req.State.ReferenceArgs, req.Inputs
+
+	diff := map[string]p.PropertyDiff{}
+	for name, changed := range map[string]bool{
+		"backendType":     olds.BackendType != news.BackendType,
+		"workspace":       !reflect.DeepEqual(olds.Workspace, news.Workspace),
+		"config":          !reflect.DeepEqual(olds.Config, news.Config),
+		"secretConfig":    !reflect.DeepEqual(olds.SecretConfig, news.SecretConfig),
+		"requiredOutputs": !reflect.DeepEqual(olds.RequiredOutputs, news.RequiredOutputs),
+		"timeout":         !reflect.DeepEqual(olds.Timeout, news.Timeout),
+		"env":             !reflect.DeepEqual(olds.Env, news.Env),
+	} {
+		if changed {
+			diff[name] = p.PropertyDiff{Kind: p.Update, InputDiff: true}
+		}
+	}
+
+	if len(diff) == 0 {
+		current, err := news.read(ctx)
+		if err != nil {
+			return infer.DiffResponse{}, err
+		}
+		if current.Serial != req.State.Serial {
+			diff["serial"] = p.PropertyDiff{Kind: p.Update}
+		}
+		if current.Lineage != req.State.Lineage {
+			diff["lineage"] = p.PropertyDiff{Kind: p.Update}
+		}
+	}
+
+	return infer.DiffResponse{
+		HasChanges:   len(diff) > 0,
+		DetailedDiff: diff,
+	}, nil
+}
+
+func (r *Reference) Update(
+	ctx context.Context, req infer.UpdateRequest[ReferenceArgs, ReferenceState],
+) (infer.UpdateResponse[ReferenceState], error) {
+	// Changed inputs may not be known yet during a preview. Reading is safe
+	// otherwise, and shows the new outputs in the preview.
+	if req.DryRun && !reflect.DeepEqual(req.State.ReferenceArgs, req.Inputs) {
+		return infer.UpdateResponse[ReferenceState]{
+			Output: ReferenceState{ReferenceArgs: req.Inputs},
+		}, nil
+	}
+
+	state, err := req.Inputs.read(ctx)
+	if err != nil {
+		return infer.UpdateResponse[ReferenceState]{}, err
+	}
+	return infer.UpdateResponse[ReferenceState]{Output: state}, nil
+}
+
+// Read refreshes the state the reference points at. The inputs are those the
+// reference was last created or updated with, or the ones recorded in its state when
+// the engine has none. An import has neither, and there is nothing to read the state
+// from.
+func (r *Reference) Read(
+	ctx context.Context, req infer.ReadRequest[ReferenceArgs, ReferenceState],
+) (infer.ReadResponse[ReferenceArgs, ReferenceState], error) {
+	inputs := req.Inputs
+	if inputs.BackendType == "" {
+		inputs = req.State.ReferenceArgs
+	}
+	if inputs.BackendType == "" {
+		return infer.ReadResponse[ReferenceArgs, ReferenceState]{}, fmt.Errorf(
+			"cannot read reference %q without its backendType and config: references cannot be imported, "+
+				"declare the reference in the program instead", req.ID)
+	}
+
+	state, err := inputs.read(ctx)
+	if err != nil {
+		return infer.ReadResponse[ReferenceArgs, ReferenceState]{}, err
+	}
+	return infer.ReadResponse[ReferenceArgs, ReferenceState]{
+		ID:     req.ID,
+		Inputs: inputs,
+		State:  state,
+	}, nil
+}
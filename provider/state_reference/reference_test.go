@@ -0,0 +1,153 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// TestReference walks a Reference through its lifecycle while the upstream state
+// it points at moves on to a new serial.
+func TestReference(t *testing.T) {
+	ctx := t.Context()
+	InitTfBackend()
+
+	original, err := os.ReadFile("testdata/test.tfstate")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(path, original, 0o600))
+
+	r := &Reference{}
+	args := ReferenceArgs{
+		BackendArgs: BackendArgs{
+			BackendType: "local",
+			Config:      map[string]any{localPathAttribute: path},
+		},
+		WorkspaceArgs: WorkspaceArgs{Workspace: ptr(defaultWorkspace)},
+	}
+
+	preview, err := r.Create(ctx, infer.CreateRequest[ReferenceArgs]{Name: "ref", Inputs: args, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, ReferenceState{ReferenceArgs: args}, preview.Output)
+
+	created, err := r.Create(ctx, infer.CreateRequest[ReferenceArgs]{Name: "ref", Inputs: args})
+	require.NoError(t, err)
+	assert.Equal(t, "ref", created.ID)
+	assert.Equal(t, map[string]any{"greeting": "hello", "count": 42}, created.Output.Outputs)
+	assert.Equal(t, 1, created.Output.Serial)
+	assert.Equal(t, "test-lineage", created.Output.Lineage)
+
+	diff := func(t *testing.T, inputs ReferenceArgs) infer.DiffResponse {
+		resp, err := r.Diff(ctx, infer.DiffRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, State: created.Output, Inputs: inputs,
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		assert.False(t, diff(t, args).HasChanges)
+	})
+
+	// Terraform applies a change upstream.
+	changed := strings.Replace(string(original), `"serial": 1`, `"serial": 2`, 1)
+	changed = strings.Replace(changed, `"hello"`, `"hi"`, 1)
+	require.NoError(t, os.WriteFile(path, []byte(changed), 0o600))
+
+	t.Run("upstream serial changed", func(t *testing.T) {
+		resp := diff(t, args)
+		assert.True(t, resp.HasChanges)
+		assert.Equal(t, map[string]p.PropertyDiff{"serial": {Kind: p.Update}}, resp.DetailedDiff)
+
+		updated, err := r.Update(ctx, infer.UpdateRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, State: created.Output, Inputs: args, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, updated.Output.Serial)
+		assert.Equal(t, map[string]any{"greeting": "hi", "count": 42}, updated.Output.Outputs)
+	})
+
+	t.Run("inputs changed", func(t *testing.T) {
+		moved := args
+		moved.Config = map[string]any{localPathAttribute: filepath.Join(t.TempDir(), "missing.tfstate")}
+
+		resp := diff(t, moved)
+		assert.True(t, resp.HasChanges)
+		assert.Equal(t, map[string]p.PropertyDiff{"config": {Kind: p.Update, InputDiff: true}}, resp.DetailedDiff)
+
+		// The new inputs are not read during a preview.
+		updated, err := r.Update(ctx, infer.UpdateRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, State: created.Output, Inputs: moved, DryRun: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, ReferenceState{ReferenceArgs: moved}, updated.Output)
+	})
+
+	t.Run("read arguments changed", func(t *testing.T) {
+		bounded := args
+		bounded.Timeout = ptr("soon")
+
+		resp := diff(t, bounded)
+		assert.Equal(t, map[string]p.PropertyDiff{"timeout": {Kind: p.Update, InputDiff: true}}, resp.DetailedDiff)
+
+		// The timeout is parsed by the read.
+		_, err := r.Update(ctx, infer.UpdateRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, State: created.Output, Inputs: bounded,
+		})
+		assert.ErrorContains(t, err, `invalid timeout "soon"`)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		read, err := r.Read(ctx, infer.ReadRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, Inputs: args, State: created.Output,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, read.ID)
+		assert.Equal(t, 2, read.State.Serial)
+	})
+
+	t.Run("refresh without defaults", func(t *testing.T) {
+		inputs := args
+		inputs.Workspace = nil
+		read, err := r.Read(ctx, infer.ReadRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, Inputs: inputs, State: created.Output,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, read.State.Serial)
+	})
+
+	t.Run("refresh from state", func(t *testing.T) {
+		read, err := r.Read(ctx, infer.ReadRequest[ReferenceArgs, ReferenceState]{
+			ID: created.ID, State: created.Output,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, args, read.Inputs)
+		assert.Equal(t, 2, read.State.Serial)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		_, err := r.Read(ctx, infer.ReadRequest[ReferenceArgs, ReferenceState]{ID: "imported"})
+		assert.ErrorContains(t, err, `cannot read reference "imported" without its backendType and config`)
+	})
+}
@@ -17,9 +17,9 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform/shim"
 	"github.com/zclconf/go-cty/cty"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -40,14 +40,40 @@ type GetRemoteReferenceArgs struct {
 	Organization string     `pulumi:"organization"`
 	Token        *string    `pulumi:"token,optional" provider:"secret"`
 	Workspaces   Workspaces `pulumi:"workspaces"`
+
+	OutputArgs
+	ReadArgs
 }
 
 func (r *GetRemoteReferenceArgs) Annotate(a infer.Annotator) {
-	a.Describe(&r.Hostname, "The remote backend hostname to connect to.")
+	a.Describe(&r.Hostname, "The remote backend hostname to connect to. Defaults to app.terraform.io.")
 	a.Describe(&r.Organization, "The name of the organization containing the targeted workspace(s).")
 	a.Describe(&r.Token, "The token used to authenticate with the remote backend.")
+}
+
+// RemoteDefaults holds provider-wide fallbacks for the connection arguments of
+// getRemoteReference. They are also used by the functions taking a backendType of
+// remote or cloud.
+type RemoteDefaults struct {
+	Hostname *string `pulumi:"hostname,optional"`
+	Token    *string `pulumi:"token,optional" provider:"secret"`
+}
+
+func (r *RemoteDefaults) Annotate(a infer.Annotator) {
+	a.Describe(&r.Hostname, "The default hostname to connect to.")
+	a.Describe(&r.Token, "The default token used to authenticate with the backend.")
+}
 
-	a.SetDefault(&r.Hostname, "app.terraform.io")
+// backendConfig builds the remote or cloud backend configuration the defaults
+// provide, keyed by the backends' attribute names.
+func (r *RemoteDefaults) backendConfig() map[string]cty.Value {
+	if r == nil {
+		return nil
+	}
+	return map[string]cty.Value{
+		"hostname": ctyStringOrNil(r.Hostname),
+		"token":    ctyStringOrNil(r.Token),
+	}
 }
 
 // WireDependencies lets us tell user's that our outputs shouldn't be secret, even when
@@ -88,20 +114,34 @@ func (r *Workspaces) Annotate(a infer.Annotator) {
 		"the default workspace can be used. This option conflicts with name.")
 }
 
+// backendConfig builds the remote backend configuration, keyed by the backend's
+// attribute names.
+func (r *GetRemoteReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"hostname":     ctyStringOrNil(r.Hostname),
+		"organization": cty.StringVal(r.Organization),
+		"token":        ctyStringOrNil(r.Token),
+		"workspaces": cty.ObjectVal(map[string]cty.Value{
+			"name":   ctyStringOrNil(r.Workspaces.Name),
+			"prefix": ctyStringOrNil(r.Workspaces.Prefix),
+		}),
+	}
+}
+
+// check validates the arguments before reading.
+func (r *GetRemoteReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "remote", r.backendConfig(), r.Env, argumentPath)
+}
+
 func (r *GetRemoteReference) Invoke(
 	ctx context.Context, req infer.FunctionRequest[GetRemoteReferenceArgs],
 ) (infer.FunctionResponse[StateReferenceOutputs], error) {
 	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
 
-	results, err := shim.StateReferenceRead(ctx, "remote", args.Workspaces.stateMgrName(), map[string]cty.Value{
-		"hostname":     ctyStringOrNil(args.Hostname),
-		"organization": cty.StringVal(args.Organization),
-		"token":        ctyStringOrNil(args.Token),
-		"workspaces": cty.ObjectVal(map[string]cty.Value{
-			"name":   ctyStringOrNil(args.Workspaces.Name),
-			"prefix": ctyStringOrNil(args.Workspaces.Prefix),
-		}),
-	})
-
-	return infer.FunctionResponse[StateReferenceOutputs]{Output: StateReferenceOutputs{results}}, err
+	return readStateReference(ctx, "remote", args.Workspaces.stateMgrName(), args.backendConfig(),
+		args.readOptions(args.OutputArgs))
 }
@@ -64,22 +64,22 @@ func TestWorkspacesStateMgrName(t *testing.T) {
 func TestStateReferenceReadLocal(t *testing.T) {
 	InitTfBackend()
 
-	outputs, err := shim.StateReferenceRead(
+	state, err := shim.StateReferenceRead(
 		context.Background(), "local", defaultWorkspace, map[string]cty.Value{
 			localPathAttribute: cty.StringVal("testdata/test.tfstate"),
-		},
+		}, shim.Environment{},
 	)
 	require.NoError(t, err)
 
-	assert.Equal(t, "hello", outputs["greeting"])
-	assert.Equal(t, float64(42), outputs["count"])
+	assert.Equal(t, "hello", state.Outputs["greeting"])
+	assert.Equal(t, 42, state.Outputs["count"])
 }
 
 func TestStateReferenceReadUnsupportedBackend(t *testing.T) {
 	InitTfBackend()
 
 	_, err := shim.StateReferenceRead(
-		context.Background(), "nonexistent", defaultWorkspace, map[string]cty.Value{},
+		context.Background(), "nonexistent", defaultWorkspace, map[string]cty.Value{}, shim.Environment{},
 	)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unsupported backend type")
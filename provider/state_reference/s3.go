@@ -17,9 +17,9 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform/shim"
 	"github.com/zclconf/go-cty/cty"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
@@ -75,6 +75,9 @@ type GetS3ReferenceArgs struct {
 	SkipCredentialsValidation *bool `pulumi:"skipCredentialsValidation,optional"`
 	SkipRegionValidation      *bool `pulumi:"skipRegionValidation,optional"`
 	SkipMetadataAPICheck      *bool `pulumi:"skipMetadataApiCheck,optional"`
+
+	OutputArgs
+	ReadArgs
 }
 
 func (r *GetS3ReferenceArgs) Annotate(a infer.Annotator) {
@@ -124,6 +127,88 @@ func (r *GetS3ReferenceArgs) Annotate(a infer.Annotator) {
 	a.SetDefault(&r.Workspace, defaultWorkspace)
 }
 
+// S3Defaults holds provider-wide fallbacks for the connection and credential arguments
+// of getS3Reference. They are also used by the functions taking a backendType of s3.
+type S3Defaults struct {
+	Region      *string `pulumi:"region,optional"`
+	Endpoint    *string `pulumi:"endpoint,optional"`
+	StsEndpoint *string `pulumi:"stsEndpoint,optional"`
+	IamEndpoint *string `pulumi:"iamEndpoint,optional"`
+
+	ForcePathStyle *bool `pulumi:"forcePathStyle,optional"`
+
+	AccessKey             *string `pulumi:"accessKey,optional" provider:"secret"`
+	SecretKey             *string `pulumi:"secretKey,optional" provider:"secret"`
+	Token                 *string `pulumi:"token,optional" provider:"secret"`
+	Profile               *string `pulumi:"profile,optional"`
+	SharedCredentialsFile *string `pulumi:"sharedCredentialsFile,optional"`
+
+	RoleArn                   *string `pulumi:"roleArn,optional"`
+	SessionName               *string `pulumi:"sessionName,optional"`
+	ExternalID                *string `pulumi:"externalId,optional"`
+	AssumeRoleDurationSeconds *int    `pulumi:"assumeRoleDurationSeconds,optional"`
+
+	MaxRetries *int `pulumi:"maxRetries,optional"`
+
+	SkipCredentialsValidation *bool `pulumi:"skipCredentialsValidation,optional"`
+	SkipRegionValidation      *bool `pulumi:"skipRegionValidation,optional"`
+	SkipMetadataAPICheck      *bool `pulumi:"skipMetadataApiCheck,optional"`
+}
+
+func (r *S3Defaults) Annotate(a infer.Annotator) {
+	a.Describe(&r.Region, "The default AWS region of the S3 bucket.")
+	a.Describe(&r.Endpoint, "The default custom endpoint for the S3 API.")
+	a.Describe(&r.StsEndpoint, "The default custom endpoint for the STS API.")
+	a.Describe(&r.IamEndpoint, "The default custom endpoint for the IAM API.")
+
+	a.Describe(&r.ForcePathStyle, "Whether to force path-style addressing by default.")
+
+	a.Describe(&r.AccessKey, "The default AWS access key.")
+	a.Describe(&r.SecretKey, "The default AWS secret key.")
+	a.Describe(&r.Token, "The default AWS session token.")
+	a.Describe(&r.Profile, "The default AWS profile name.")
+	a.Describe(&r.SharedCredentialsFile, "The default path to a shared credentials file.")
+
+	a.Describe(&r.RoleArn, "The ARN of the IAM Role assumed by default in order to read the state.")
+	a.Describe(&r.SessionName, "The default session name to use when assuming the role.")
+	a.Describe(&r.ExternalID, "The default external ID to use when assuming the role.")
+	a.Describe(&r.AssumeRoleDurationSeconds, "The default duration, in seconds, of the assume role session.")
+
+	a.Describe(&r.MaxRetries, "The default maximum number of times an AWS API request is retried.")
+
+	a.Describe(&r.SkipCredentialsValidation, "Whether to skip the credentials validation by default.")
+	a.Describe(&r.SkipRegionValidation, "Whether to skip static validation of the region name by default.")
+	a.Describe(&r.SkipMetadataAPICheck, "Whether to skip the AWS Metadata API check by default.")
+}
+
+// backendConfig builds the s3 backend configuration the defaults provide, keyed by
+// the backend's attribute names.
+func (r *S3Defaults) backendConfig() map[string]cty.Value {
+	if r == nil {
+		return nil
+	}
+	return map[string]cty.Value{
+		"region":                       ctyStringOrNil(r.Region),
+		"endpoint":                     ctyStringOrNil(r.Endpoint),
+		"sts_endpoint":                 ctyStringOrNil(r.StsEndpoint),
+		"iam_endpoint":                 ctyStringOrNil(r.IamEndpoint),
+		"force_path_style":             ctyBoolOrNil(r.ForcePathStyle),
+		"access_key":                   ctyStringOrNil(r.AccessKey),
+		"secret_key":                   ctyStringOrNil(r.SecretKey),
+		"token":                        ctyStringOrNil(r.Token),
+		"profile":                      ctyStringOrNil(r.Profile),
+		"shared_credentials_file":      ctyStringOrNil(r.SharedCredentialsFile),
+		"role_arn":                     ctyStringOrNil(r.RoleArn),
+		"session_name":                 ctyStringOrNil(r.SessionName),
+		"external_id":                  ctyStringOrNil(r.ExternalID),
+		"assume_role_duration_seconds": ctyIntOrNil(r.AssumeRoleDurationSeconds),
+		"max_retries":                  ctyIntOrNil(r.MaxRetries),
+		"skip_credentials_validation":  ctyBoolOrNil(r.SkipCredentialsValidation),
+		"skip_region_validation":       ctyBoolOrNil(r.SkipRegionValidation),
+		"skip_metadata_api_check":      ctyBoolOrNil(r.SkipMetadataAPICheck),
+	}
+}
+
 // WireDependencies lets us tell users that our outputs shouldn't be secret, even when
 // the credentials (when provided) are always secret.
 //
@@ -136,41 +221,55 @@ func (r *GetS3Reference) WireDependencies(
 	f.OutputField(&state).NeverSecret() // The output should never be secret by default
 }
 
+// backendConfig builds the s3 backend configuration, keyed by the backend's attribute
+// names.
+func (r *GetS3ReferenceArgs) backendConfig() map[string]cty.Value {
+	return map[string]cty.Value{
+		"bucket":                          cty.StringVal(r.Bucket),
+		"key":                             cty.StringVal(r.Key),
+		"region":                          ctyStringOrNil(r.Region),
+		"endpoint":                        ctyStringOrNil(r.Endpoint),
+		"sts_endpoint":                    ctyStringOrNil(r.StsEndpoint),
+		"iam_endpoint":                    ctyStringOrNil(r.IamEndpoint),
+		"force_path_style":                ctyBoolOrNil(r.ForcePathStyle),
+		"access_key":                      ctyStringOrNil(r.AccessKey),
+		"secret_key":                      ctyStringOrNil(r.SecretKey),
+		"token":                           ctyStringOrNil(r.Token),
+		"profile":                         ctyStringOrNil(r.Profile),
+		"shared_credentials_file":         ctyStringOrNil(r.SharedCredentialsFile),
+		"role_arn":                        ctyStringOrNil(r.RoleArn),
+		"session_name":                    ctyStringOrNil(r.SessionName),
+		"external_id":                     ctyStringOrNil(r.ExternalID),
+		"assume_role_duration_seconds":    ctyIntOrNil(r.AssumeRoleDurationSeconds),
+		"assume_role_policy":              ctyStringOrNil(r.AssumeRolePolicy),
+		"assume_role_policy_arns":         ctyStringSetOrNil(r.AssumeRolePolicyArns),
+		"assume_role_tags":                ctyStringMapOrNil(r.AssumeRoleTags),
+		"assume_role_transitive_tag_keys": ctyStringSetOrNil(r.AssumeRoleTransitiveTagKeys),
+		"encrypt":                         ctyBoolOrNil(r.Encrypt),
+		"kms_key_id":                      ctyStringOrNil(r.KmsKeyID),
+		"sse_customer_key":                ctyStringOrNil(r.SseCustomerKey),
+		"workspace_key_prefix":            ctyStringOrNil(r.WorkspaceKeyPrefix),
+		"max_retries":                     ctyIntOrNil(r.MaxRetries),
+		"skip_credentials_validation":     ctyBoolOrNil(r.SkipCredentialsValidation),
+		"skip_region_validation":          ctyBoolOrNil(r.SkipRegionValidation),
+		"skip_metadata_api_check":         ctyBoolOrNil(r.SkipMetadataAPICheck),
+	}
+}
+
+// check validates the arguments as the s3 backend does before reading.
+func (r *GetS3ReferenceArgs) check(ctx context.Context) []p.CheckFailure {
+	return checkBackend(ctx, "s3", r.backendConfig(), r.Env, argumentPath)
+}
+
 func (r *GetS3Reference) Invoke(
 	ctx context.Context, req infer.FunctionRequest[GetS3ReferenceArgs],
 ) (infer.FunctionResponse[StateReferenceOutputs], error) {
 	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
 
-	results, err := shim.StateReferenceRead(ctx, "s3", *args.Workspace, map[string]cty.Value{
-		"bucket":                          cty.StringVal(args.Bucket),
-		"key":                             cty.StringVal(args.Key),
-		"region":                          ctyStringOrNil(args.Region),
-		"endpoint":                        ctyStringOrNil(args.Endpoint),
-		"sts_endpoint":                    ctyStringOrNil(args.StsEndpoint),
-		"iam_endpoint":                    ctyStringOrNil(args.IamEndpoint),
-		"force_path_style":                ctyBoolOrNil(args.ForcePathStyle),
-		"access_key":                      ctyStringOrNil(args.AccessKey),
-		"secret_key":                      ctyStringOrNil(args.SecretKey),
-		"token":                           ctyStringOrNil(args.Token),
-		"profile":                         ctyStringOrNil(args.Profile),
-		"shared_credentials_file":         ctyStringOrNil(args.SharedCredentialsFile),
-		"role_arn":                        ctyStringOrNil(args.RoleArn),
-		"session_name":                    ctyStringOrNil(args.SessionName),
-		"external_id":                     ctyStringOrNil(args.ExternalID),
-		"assume_role_duration_seconds":    ctyIntOrNil(args.AssumeRoleDurationSeconds),
-		"assume_role_policy":              ctyStringOrNil(args.AssumeRolePolicy),
-		"assume_role_policy_arns":         ctyStringSetOrNil(args.AssumeRolePolicyArns),
-		"assume_role_tags":                ctyStringMapOrNil(args.AssumeRoleTags),
-		"assume_role_transitive_tag_keys": ctyStringSetOrNil(args.AssumeRoleTransitiveTagKeys),
-		"encrypt":                         ctyBoolOrNil(args.Encrypt),
-		"kms_key_id":                      ctyStringOrNil(args.KmsKeyID),
-		"sse_customer_key":                ctyStringOrNil(args.SseCustomerKey),
-		"workspace_key_prefix":            ctyStringOrNil(args.WorkspaceKeyPrefix),
-		"max_retries":                     ctyIntOrNil(args.MaxRetries),
-		"skip_credentials_validation":     ctyBoolOrNil(args.SkipCredentialsValidation),
-		"skip_region_validation":          ctyBoolOrNil(args.SkipRegionValidation),
-		"skip_metadata_api_check":         ctyBoolOrNil(args.SkipMetadataAPICheck),
-	})
-
-	return infer.FunctionResponse[StateReferenceOutputs]{Output: StateReferenceOutputs{results}}, err
+	return readStateReference(ctx, "s3", *args.Workspace, args.backendConfig(),
+		args.readOptions(args.OutputArgs))
 }
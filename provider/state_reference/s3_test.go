@@ -65,7 +65,7 @@ func TestStateReferenceReadS3(t *testing.T) {
 
 	assert.Equal(t, map[string]any{
 		"greeting": greeting,
-		"number":   float64(42),
+		"number":   42,
 	}, resp.Output.Outputs)
 }
 
@@ -100,7 +100,7 @@ func TestStateReferenceReadS3AssumeRole(t *testing.T) {
 
 	assert.Equal(t, map[string]any{
 		"greeting": greeting,
-		"number":   float64(42),
+		"number":   42,
 	}, resp.Output.Outputs)
 }
 
@@ -15,21 +15,57 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform/shim"
 	"github.com/zclconf/go-cty/cty"
 
+	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
 )
 
+// largeIntegers documents how the values read from state represent integers, for the
+// descriptions of the properties holding them.
+const largeIntegers = "Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned " +
+	"as their exact decimal string instead. A value that crosses that bound changes type between states."
+
 // defaultWorkspace is the sentinel name Terraform backends use for the
 // implicit, always-present workspace.
 const defaultWorkspace = "default"
 
 func InitTfBackend() { shim.InitTfBackend() }
 
+// RedirectTerraformLogs forwards the log messages of Terraform's backends emitted by
+// the calling goroutine, and by the reads made under the returned context, to w until
+// stop is called.
+func RedirectTerraformLogs(ctx context.Context, w io.Writer) (_ context.Context, stop func()) {
+	return shim.RedirectLogs(ctx, w)
+}
+
+// RedirectUnattributedTerraformLogs writes the log messages of Terraform's backends
+// that belong to no call to w, instead of to the standard error of the provider, until
+// restore is called.
+func RedirectUnattributedTerraformLogs(w io.Writer) (restore func()) {
+	return shim.RedirectUnattributedLogs(w)
+}
+
 type StateReferenceOutputs struct {
 	// Outputs is a map of the outputs from the Terraform state file
 	Outputs map[string]any `pulumi:"outputs"`
+	// Resources lists the resource instances in the Terraform state file, when they
+	// were asked for
+	Resources []StateResource `pulumi:"resources,optional"`
+
+	Serial             int     `pulumi:"serial"`
+	Lineage            string  `pulumi:"lineage"`
+	TerraformVersion   *string `pulumi:"terraformVersion,optional"`
+	StateFormatVersion *int    `pulumi:"stateFormatVersion,optional"`
 }
 
 var _ = (infer.Annotated)((*StateReferenceOutputs)(nil))
@@ -38,7 +74,264 @@ var _ = (infer.Annotated)((*StateReferenceOutputs)(nil))
 // be visible in the provider's schema and the generated SDKs.
 func (r *StateReferenceOutputs) Annotate(a infer.Annotator) {
 	a.Describe(&r, "The result of fetching from a Terraform state store.")
-	a.Describe(&r.Outputs, "The outputs displayed from Terraform state.")
+	a.Describe(&r.Outputs, "The outputs displayed from Terraform state. "+largeIntegers)
+	a.Describe(&r.Resources, "The resource instances recorded in Terraform state, across all modules. "+
+		"Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources "+
+		"or resources is set.")
+	a.Describe(&r.Serial, "The serial of the state snapshot that was read. Of two snapshots with the same "+
+		"lineage, the one with the higher serial is newer.")
+	a.Describe(&r.Lineage, "The lineage of the state snapshot that was read, assigned when the state was "+
+		"first created.")
+	a.Describe(&r.TerraformVersion, "The version of Terraform that wrote the state snapshot. Unset when the "+
+		"backend does not record it.")
+	a.Describe(&r.StateFormatVersion, "The version of the state file format the snapshot is stored in. Unset "+
+		"when the backend does not expose the raw snapshot, as with the cloud backend.")
+}
+
+// StateResource is a single resource instance recorded in Terraform state.
+type StateResource struct {
+	Address    string         `pulumi:"address"`
+	Module     string         `pulumi:"module"`
+	Mode       string         `pulumi:"mode"`
+	Type       string         `pulumi:"type"`
+	Name       string         `pulumi:"name"`
+	Provider   string         `pulumi:"provider"`
+	IndexKey   any            `pulumi:"indexKey,optional"`
+	Attributes map[string]any `pulumi:"attributes"`
+}
+
+var _ = (infer.Annotated)((*StateResource)(nil))
+
+func (r *StateResource) Annotate(a infer.Annotator) {
+	a.Describe(&r.Address, "The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].")
+	a.Describe(&r.Module, "The address of the module instance holding the resource. Empty for the root module.")
+	a.Describe(&r.Mode, "managed for resources and data for data sources.")
+	a.Describe(&r.Type, "The resource type, e.g. aws_subnet.")
+	a.Describe(&r.Name, "The name of the resource in its module, e.g. private.")
+	a.Describe(&r.Provider, "The provider configuration that last managed the resource, e.g. "+
+		"provider[\"registry.terraform.io/hashicorp/aws\"].")
+	a.Describe(&r.IndexKey, "The count index or for_each key of the instance. Unset when the resource uses "+
+		"neither count nor for_each.")
+	a.Describe(&r.Attributes, "The attributes of the instance, as recorded in state. "+largeIntegers)
+}
+
+// ReadArgs are the arguments of every function reading from a backend, which embed
+// them in their own.
+type ReadArgs struct {
+	Timeout *string           `pulumi:"timeout,optional"`
+	Env     map[string]string `pulumi:"env,optional" provider:"secret" redact:"credentials"`
+}
+
+var _ = (infer.Annotated)((*ReadArgs)(nil))
+
+func (r *ReadArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Timeout, "How long to wait for the backend to be read, as a duration such as 30s or 2m. "+
+		"Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.")
+	a.Describe(&r.Env, "Environment variables to set while the backend is read, overriding those of the "+
+		"provider process. The backend falls back to them for settings its arguments leave unset.")
+}
+
+// check checks that the timeout is a positive duration.
+func (r *ReadArgs) check() []p.CheckFailure {
+	if _, err := parseDuration("timeout", r.Timeout); err != nil {
+		return []p.CheckFailure{{Property: "timeout", Reason: err.Error()}}
+	}
+	return nil
+}
+
+// readOptions returns the options of a read with r, returning the outputs selected by
+// outputs.
+func (r ReadArgs) readOptions(outputs OutputArgs) readOptions {
+	return readOptions{
+		outputs:          outputs.Outputs,
+		requiredOutputs:  outputs.RequiredOutputs,
+		includeResources: (outputs.IncludeResources != nil && *outputs.IncludeResources) || outputs.Resources != nil,
+		resources:        outputs.Resources,
+		timeout:          r.Timeout,
+		env:              r.Env,
+	}
+}
+
+// OutputArgs are the arguments selecting the outputs and resources of the functions
+// returning whole states, which embed them in their own.
+type OutputArgs struct {
+	Outputs          []string `pulumi:"outputs,optional"`
+	RequiredOutputs  []string `pulumi:"requiredOutputs,optional"`
+	IncludeResources *bool    `pulumi:"includeResources,optional"`
+	Resources        []string `pulumi:"resources,optional"`
+}
+
+var _ = (infer.Annotated)((*OutputArgs)(nil))
+
+func (r *OutputArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Outputs, "The names of the outputs to return. When set, only these outputs and those in "+
+		"requiredOutputs are returned. Names the state does not contain are skipped.")
+	a.Describe(&r.RequiredOutputs, "The names of the outputs the state must contain. Reading a state missing "+
+		"any of them fails, naming the missing outputs.")
+	a.Describe(&r.IncludeResources, "Whether to return the resource instances recorded in the state. They are "+
+		"left out by default, as states can hold many of them.")
+	a.Describe(&r.Resources, "The addresses of the resources or modules whose instances to return, e.g. "+
+		"aws_subnet.private or module.network. When set, only those instances are returned, and includeResources "+
+		"is implied.")
+}
+
+// readOptions holds the arguments shared by every state reference function.
+type readOptions struct {
+	// outputs, when non-nil, limits the outputs returned to these and requiredOutputs.
+	outputs []string
+	// requiredOutputs are the outputs the state must contain.
+	requiredOutputs []string
+	// includeResources is whether resource instances are returned.
+	includeResources bool
+	// resources, when non-nil, limits the resource instances returned to those at or
+	// under these addresses.
+	resources []string
+	// timeout, when set, overrides the provider's default timeout for the read.
+	timeout *string
+	// env are environment variables set for the read.
+	env map[string]string
+}
+
+// readStateReference reads the state of workspace from the backend and returns its
+// outputs and resource instances, with the values Terraform marks as sensitive
+// returned as secrets.
+func readStateReference(
+	ctx context.Context, backendType, workspace string, config map[string]cty.Value, opts readOptions,
+) (infer.FunctionResponse[StateReferenceOutputs], error) {
+	ctx, cancel, err := withTimeout(ctx, opts.timeout)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, argumentError(backendType, err)
+	}
+	defer cancel()
+
+	cfg := providerConfig(ctx)
+	config = cfg.withBackendDefaults(backendType, config)
+	state, err := cfg.readState(ctx, backendType, workspace, config, cfg.environment(opts.env))
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+
+	outputs, err := stateReferenceOutputs(backendType, workspace, state, opts)
+	if err != nil {
+		return infer.FunctionResponse[StateReferenceOutputs]{}, err
+	}
+	return infer.FunctionResponse[StateReferenceOutputs]{Output: outputs}, nil
+}
+
+// stateReferenceOutputs converts state, read from workspace of the backendType
+// backend, into the result of a state reference.
+func stateReferenceOutputs(
+	backendType, workspace string, state *shim.State, opts readOptions,
+) (StateReferenceOutputs, error) {
+	var missing []string
+	for _, k := range opts.requiredOutputs {
+		if _, ok := state.Outputs[k]; !ok && !slices.Contains(missing, k) {
+			missing = append(missing, k)
+		}
+	}
+	if len(missing) > 0 {
+		return StateReferenceOutputs{}, &shim.ReadError{
+			BackendType: backendType,
+			Workspace:   workspace,
+			Stage:       shim.StageOutputs,
+			Reason:      shim.ReasonOutputNotFound,
+			Err:         fmt.Errorf("state is missing required outputs: %s", strings.Join(missing, ", ")),
+		}
+	}
+
+	outputs := make(map[string]any, len(state.Outputs))
+	for k, v := range state.Outputs {
+		if opts.outputs != nil && !slices.Contains(opts.outputs, k) && !slices.Contains(opts.requiredOutputs, k) {
+			continue
+		}
+		if state.SensitiveOutputs[k] {
+			v = secretValue(v)
+		}
+		outputs[k] = v
+	}
+
+	var resources []StateResource
+	for _, rs := range state.Resources {
+		if !opts.includeResources || (opts.resources != nil && !matchesAddress(rs.Address, opts.resources)) {
+			continue
+		}
+		attributes := make(map[string]any, len(rs.Attributes))
+		for k, v := range rs.Attributes {
+			if rs.SensitiveAttributes[k] {
+				v = secretValue(v)
+			}
+			attributes[k] = v
+		}
+		resources = append(resources, StateResource{
+			Address:    rs.Address,
+			Module:     rs.Module,
+			Mode:       rs.Mode,
+			Type:       rs.Type,
+			Name:       rs.Name,
+			Provider:   rs.Provider,
+			IndexKey:   rs.IndexKey,
+			Attributes: attributes,
+		})
+	}
+
+	return StateReferenceOutputs{
+		Outputs:            outputs,
+		Resources:          resources,
+		Serial:             int(state.Serial),
+		Lineage:            state.Lineage,
+		TerraformVersion:   stringOrNil(state.TerraformVersion),
+		StateFormatVersion: state.StateFormatVersion,
+	}, nil
+}
+
+// matchesAddress reports whether the resource instance at address is at or under one
+// of addresses, as module.network.aws_subnet.private[0] is under both
+// module.network.aws_subnet.private and module.network.
+func matchesAddress(address string, addresses []string) bool {
+	for _, a := range addresses {
+		if address == a || strings.HasPrefix(address, a+".") || strings.HasPrefix(address, a+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// secretValue marks v as secret.
+//
+// infer refuses to encode secrets nested inside a plain Go value, so v is wrapped in
+// the object Pulumi uses to represent secrets on the wire. UnwrapSecrets turns that
+// object back into a secret once infer has encoded the response.
+func secretValue(v any) map[string]any {
+	return map[string]any{resource.SigKey: resource.SecretSig, "value": v}
+}
+
+// UnwrapSecrets replaces the values wrapped by secretValue in m with secrets.
+func UnwrapSecrets(m property.Map) property.Map {
+	return unwrapSecrets(property.New(m)).AsMap()
+}
+
+func unwrapSecrets(v property.Value) property.Value {
+	switch {
+	case v.IsMap():
+		m := v.AsMap()
+		if sig, ok := m.GetOk(resource.SigKey); ok && sig.IsString() && sig.AsString() == resource.SecretSig {
+			return unwrapSecrets(m.Get("value")).WithSecret(true)
+		}
+		unwrapped := make(map[string]property.Value, m.Len())
+		for k, e := range m.All {
+			unwrapped[k] = unwrapSecrets(e)
+		}
+		return property.WithGoValue(v, property.NewMap(unwrapped))
+	case v.IsArray():
+		a := v.AsArray()
+		unwrapped := make([]property.Value, 0, a.Len())
+		for _, e := range a.All {
+			unwrapped = append(unwrapped, unwrapSecrets(e))
+		}
+		return property.WithGoValue(v, property.NewArray(unwrapped))
+	default:
+		return v
+	}
 }
 
 func ctyStringOrNil(v *string) cty.Value {
@@ -73,6 +366,17 @@ func ctyStringSetOrNil(v []string) cty.Value {
 	return cty.SetVal(elems)
 }
 
+func ctyStringListOrNil(v []string) cty.Value {
+	if len(v) == 0 {
+		return cty.NullVal(cty.List(cty.String))
+	}
+	elems := make([]cty.Value, len(v))
+	for i, s := range v {
+		elems[i] = cty.StringVal(s)
+	}
+	return cty.ListVal(elems)
+}
+
 func ctyStringMapOrNil(v map[string]string) cty.Value {
 	if len(v) == 0 {
 		return cty.NullVal(cty.Map(cty.String))
@@ -90,3 +394,10 @@ func stringOrZero(v *string) string {
 	}
 	return *v
 }
+
+func stringOrNil(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,660 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/shim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
+)
+
+// TestStateReferenceReadSensitiveOutputs checks that outputs marked sensitive in
+// Terraform come back as individual secrets, leaving the other outputs alone.
+func TestStateReferenceReadSensitiveOutputs(t *testing.T) {
+	InitTfBackend()
+
+	resp, err := (&GetLocalReference{}).Invoke(t.Context(), infer.FunctionRequest[GetLocalReferenceArgs]{
+		Input: GetLocalReferenceArgs{Path: ptr("testdata/sensitive.tfstate")},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"endpoint": "db.internal:5432",
+		"password": secretValue("hunter2"),
+		"credentials": secretValue(map[string]any{
+			"username": "admin",
+			"password": "hunter2",
+		}),
+	}, resp.Output.Outputs)
+}
+
+func TestUnwrapSecrets(t *testing.T) {
+	wrapped := property.NewMap(map[string]property.Value{
+		"outputs": property.New(property.NewMap(map[string]property.Value{
+			"plain": property.New("visible"),
+			"secret": property.New(property.NewMap(map[string]property.Value{
+				resource.SigKey: property.New(resource.SecretSig),
+				"value":         property.New("hidden"),
+			})),
+			"list": property.New(property.NewArray([]property.Value{
+				property.New(property.NewMap(map[string]property.Value{
+					resource.SigKey: property.New(resource.SecretSig),
+					"value":         property.New(1.0),
+				})),
+			})),
+		})),
+	})
+
+	assert.Equal(t, property.NewMap(map[string]property.Value{
+		"outputs": property.New(property.NewMap(map[string]property.Value{
+			"plain":  property.New("visible"),
+			"secret": property.New("hidden").WithSecret(true),
+			"list": property.New(property.NewArray([]property.Value{
+				property.New(1.0).WithSecret(true),
+			})),
+		})),
+	}), UnwrapSecrets(wrapped))
+}
+
+// TestStateReferenceReadSensitiveOutputsRPC checks that sensitive outputs reach the
+// engine as secrets once the provider unwraps them.
+func TestStateReferenceReadSensitiveOutputsRPC(t *testing.T) {
+	ctx := context.Background()
+
+	InitTfBackend()
+	prov := infer.Provider(infer.Options{
+		Functions: []infer.InferredFunction{infer.Function(&GetLocalReference{})},
+		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{"state_reference": "state"},
+	})
+	invoke := prov.Invoke
+	prov.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+		resp, err := invoke(ctx, req)
+		resp.Return = UnwrapSecrets(resp.Return)
+		return resp, err
+	}
+	server, err := p.RawServer("terraform", "6.0.0", prov)(nil)
+	require.NoError(t, err)
+
+	args, err := structpb.NewStruct(map[string]any{
+		localPathAttribute: "testdata/sensitive.tfstate",
+	})
+	require.NoError(t, err)
+
+	resp, err := server.Invoke(ctx, &pulumirpc.InvokeRequest{
+		Tok:  "terraform:state:getLocalReference",
+		Args: args,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.GetFailures())
+
+	outputs := resp.GetReturn().AsMap()["outputs"].(map[string]any)
+	assert.Equal(t, "db.internal:5432", outputs["endpoint"])
+	assert.Equal(t, map[string]any{
+		resource.SigKey: resource.SecretSig,
+		"value":         "hunter2",
+	}, outputs["password"])
+}
+
+// TestStateReferenceReadResources checks that resource instances from every module
+// are returned in address order, with sensitive attributes as secrets and deposed
+// objects left out.
+func TestStateReferenceReadResources(t *testing.T) {
+	InitTfBackend()
+
+	resp, err := (&GetLocalReference{}).Invoke(t.Context(), infer.FunctionRequest[GetLocalReferenceArgs]{
+		Input: GetLocalReferenceArgs{
+			Path:       ptr("testdata/resources.tfstate"),
+			OutputArgs: OutputArgs{IncludeResources: ptr(true)},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []StateResource{
+		{
+			Address:  "data.aws_region.current",
+			Mode:     "data",
+			Type:     "aws_region",
+			Name:     "current",
+			Provider: `provider["registry.terraform.io/hashicorp/aws"]`,
+			Attributes: map[string]any{
+				"id":   "us-west-2",
+				"name": "us-west-2",
+			},
+		},
+		{
+			Address:  "random_password.db",
+			Mode:     "managed",
+			Type:     "random_password",
+			Name:     "db",
+			Provider: `provider["registry.terraform.io/hashicorp/random"]`,
+			Attributes: map[string]any{
+				"id":     "none",
+				"length": 16,
+				"result": secretValue("hunter2hunter2ab"),
+			},
+		},
+		{
+			Address:  "module.network.aws_subnet.private[2]",
+			Module:   "module.network",
+			Mode:     "managed",
+			Type:     "aws_subnet",
+			Name:     "private",
+			Provider: `provider["registry.terraform.io/hashicorp/aws"]`,
+			IndexKey: 2,
+			Attributes: map[string]any{
+				"id":   "subnet-2",
+				"tags": map[string]any{"Name": "private-2"},
+			},
+		},
+		{
+			Address:  "module.network.aws_subnet.private[10]",
+			Module:   "module.network",
+			Mode:     "managed",
+			Type:     "aws_subnet",
+			Name:     "private",
+			Provider: `provider["registry.terraform.io/hashicorp/aws"]`,
+			IndexKey: 10,
+			Attributes: map[string]any{
+				"id":   "subnet-10",
+				"tags": map[string]any{"Name": "private-10"},
+			},
+		},
+		{
+			Address:  `module.network.aws_vpc.main["blue"]`,
+			Module:   "module.network",
+			Mode:     "managed",
+			Type:     "aws_vpc",
+			Name:     "main",
+			Provider: `provider["registry.terraform.io/hashicorp/aws"]`,
+			IndexKey: "blue",
+			Attributes: map[string]any{
+				"id": "vpc-blue",
+			},
+		},
+	}, resp.Output.Resources)
+}
+
+// TestStateReferenceReadResourceFilter checks that resource instances are only
+// returned when asked for, and limited to the addresses in resources.
+func TestStateReferenceReadResourceFilter(t *testing.T) {
+	InitTfBackend()
+
+	read := func(t *testing.T, args OutputArgs) []string {
+		resp, err := (&GetLocalReference{}).Invoke(t.Context(), infer.FunctionRequest[GetLocalReferenceArgs]{
+			Input: GetLocalReferenceArgs{Path: ptr("testdata/resources.tfstate"), OutputArgs: args},
+		})
+		require.NoError(t, err)
+		var addresses []string
+		for _, r := range resp.Output.Resources {
+			addresses = append(addresses, r.Address)
+		}
+		return addresses
+	}
+
+	tests := []struct {
+		name string
+		args OutputArgs
+		want []string
+	}{
+		{name: "left out by default"},
+		{name: "excluded", args: OutputArgs{IncludeResources: ptr(false)}},
+		{
+			name: "module",
+			args: OutputArgs{Resources: []string{"module.network"}},
+			want: []string{
+				"module.network.aws_subnet.private[2]",
+				"module.network.aws_subnet.private[10]",
+				`module.network.aws_vpc.main["blue"]`,
+			},
+		},
+		{
+			name: "resources and instances",
+			args: OutputArgs{Resources: []string{"random_password.db", `module.network.aws_vpc.main["blue"]`}},
+			want: []string{"random_password.db", `module.network.aws_vpc.main["blue"]`},
+		},
+		{
+			name: "names are not prefixes",
+			args: OutputArgs{Resources: []string{"random_password.d", "module.net"}},
+		},
+		{name: "empty allowlist", args: OutputArgs{Resources: []string{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, read(t, tt.args))
+		})
+	}
+}
+
+// TestStateReferenceReadMetadata checks that the snapshot metadata is read from the
+// stored snapshot, before Terraform upgrades it to the current format.
+func TestStateReferenceReadMetadata(t *testing.T) {
+	InitTfBackend()
+
+	t.Run("local", func(t *testing.T) {
+		resp, err := (&GetLocalReference{}).Invoke(t.Context(), infer.FunctionRequest[GetLocalReferenceArgs]{
+			Input: GetLocalReferenceArgs{Path: ptr("testdata/test.tfstate")},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, resp.Output.Serial)
+		assert.Equal(t, "test-lineage", resp.Output.Lineage)
+		assert.Equal(t, ptr("1.5.7"), resp.Output.TerraformVersion)
+		assert.Equal(t, ptr(4), resp.Output.StateFormatVersion)
+	})
+
+	t.Run("remote client", func(t *testing.T) {
+		legacy, err := os.ReadFile("testdata/legacy.tfstate")
+		require.NoError(t, err)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(legacy)
+		}))
+		t.Cleanup(server.Close)
+
+		resp, err := (&GetHTTPReference{}).Invoke(t.Context(), infer.FunctionRequest[GetHTTPReferenceArgs]{
+			Input: GetHTTPReferenceArgs{Address: server.URL},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{"greeting": "hello"}, resp.Output.Outputs)
+		assert.Equal(t, 12, resp.Output.Serial)
+		assert.Equal(t, "legacy-lineage", resp.Output.Lineage)
+		assert.Equal(t, ptr("0.11.14"), resp.Output.TerraformVersion)
+		assert.Equal(t, ptr(3), resp.Output.StateFormatVersion)
+	})
+}
+
+// TestStateReferenceReadNumbers checks that integers keep their exact value, with
+// those a float64 cannot hold returned as decimal strings.
+func TestStateReferenceReadNumbers(t *testing.T) {
+	InitTfBackend()
+
+	resp, err := (&GetLocalReference{}).Invoke(t.Context(), infer.FunctionRequest[GetLocalReferenceArgs]{
+		Input: GetLocalReferenceArgs{
+			Path:       ptr("testdata/numbers.tfstate"),
+			OutputArgs: OutputArgs{IncludeResources: ptr(true)},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"account_id":   123456789012,
+		"snowflake_id": "1234567890123456789",
+		"ratio":        0.25,
+		"ports": map[string]any{
+			"http":          80,
+			"ephemeral_max": "9007199254740993",
+		},
+		"zones": []any{"us-west-2a", "us-west-2b"},
+	}, resp.Output.Outputs)
+
+	require.Len(t, resp.Output.Resources, 1)
+	assert.Equal(t, map[string]any{
+		"id":       "big",
+		"owner_id": "18446744073709551615",
+		"sizes":    []any{1, 2.5},
+	}, resp.Output.Resources[0].Attributes)
+}
+
+func TestStateReferenceReadOutputFilter(t *testing.T) {
+	InitTfBackend()
+
+	read := func(t *testing.T, args GetLocalReferenceArgs) (map[string]any, error) {
+		args.Path = ptr("testdata/sensitive.tfstate")
+		resp, err := (&GetLocalReference{}).Invoke(t.Context(),
+			infer.FunctionRequest[GetLocalReferenceArgs]{Input: args})
+		return resp.Output.Outputs, err
+	}
+
+	t.Run("allowlist", func(t *testing.T) {
+		outputs, err := read(t, GetLocalReferenceArgs{OutputArgs: OutputArgs{Outputs: []string{"endpoint", "unknown"}}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"endpoint": "db.internal:5432"}, outputs)
+	})
+
+	t.Run("empty allowlist", func(t *testing.T) {
+		outputs, err := read(t, GetLocalReferenceArgs{OutputArgs: OutputArgs{Outputs: []string{}}})
+		require.NoError(t, err)
+		assert.Empty(t, outputs)
+	})
+
+	t.Run("required outputs are returned", func(t *testing.T) {
+		outputs, err := read(t, GetLocalReferenceArgs{OutputArgs: OutputArgs{
+			Outputs:         []string{"endpoint"},
+			RequiredOutputs: []string{"password"},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"endpoint": "db.internal:5432",
+			"password": secretValue("hunter2"),
+		}, outputs)
+	})
+
+	t.Run("missing required outputs", func(t *testing.T) {
+		_, err := read(t, GetLocalReferenceArgs{OutputArgs: OutputArgs{
+			RequiredOutputs: []string{"endpoint", "vpc_id", "subnet_ids"},
+		}})
+		var readErr *shim.ReadError
+		require.ErrorAs(t, err, &readErr)
+		assert.Equal(t, shim.ReasonOutputNotFound, readErr.Reason)
+		assert.EqualError(t, readErr.Err, "state is missing required outputs: vpc_id, subnet_ids")
+	})
+}
+
+func TestRedirectTerraformLogs(t *testing.T) {
+	InitTfBackend()
+
+	tests := []struct {
+		name   string
+		tfLog  string
+		want   []string
+		ignore []string
+	}{
+		{
+			name:   "default level",
+			want:   []string{"[WARN]  backend warning", "[ERROR] backend error"},
+			ignore: []string{"backend debug"},
+		},
+		{
+			name:  "TF_LOG",
+			tfLog: "DEBUG",
+			want:  []string{"[DEBUG] backend debug", "[WARN]  backend warning", "[ERROR] backend error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TF_LOG", tt.tfLog)
+
+			var out, unattributed strings.Builder
+			defer RedirectUnattributedTerraformLogs(&unattributed)()
+			_, stop := RedirectTerraformLogs(t.Context(), &out)
+			log.Printf("[DEBUG] backend debug")
+			log.Printf("[WARN] backend warning")
+			log.Printf("[ERROR] backend error")
+			stop()
+			log.Printf("[ERROR] after stop")
+
+			for _, want := range tt.want {
+				assert.Contains(t, out.String(), want)
+			}
+			for _, ignore := range append(tt.ignore, "after stop") {
+				assert.NotContains(t, out.String(), ignore)
+			}
+			assert.Contains(t, unattributed.String(), "[ERROR] after stop")
+		})
+	}
+}
+
+// TestRedirectTerraformLogsConcurrently checks that calls redirecting logs at the same
+// time each receive their own messages, and that messages belonging to neither are
+// written to the unattributed output, redacted with the secrets of both, rather than
+// lost.
+func TestRedirectTerraformLogsConcurrently(t *testing.T) {
+	InitTfBackend()
+	t.Setenv("TF_LOG", "")
+
+	var a, b, unattributed strings.Builder
+	defer RedirectUnattributedTerraformLogs(&unattributed)()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	call := func(out *strings.Builder, name string) {
+		ctx := withSecrets(WithSecretRedaction(t.Context()), struct {
+			Secret string `provider:"secret"`
+		}{"secret-of-" + name})
+		_, stop := RedirectTerraformLogs(ctx, out)
+		defer stop()
+		started <- struct{}{}
+		<-release
+		log.Printf("[WARN] message of %s", name)
+	}
+	var wg sync.WaitGroup
+	wg.Go(func() { call(&a, "a") })
+	wg.Go(func() { call(&b, "b") })
+	<-started
+	<-started
+	// The test goroutine belongs to neither call, as goroutines started by backends.
+	log.Printf("[WARN] message of neither, holding secret-of-a and secret-of-b")
+	close(release)
+	wg.Wait()
+
+	assert.Contains(t, a.String(), "message of a")
+	assert.Contains(t, b.String(), "message of b")
+	assert.Contains(t, unattributed.String(), "[WARN]  message of neither, holding [secret] and [secret]")
+	for out, others := range map[string][]string{
+		a.String():            {"message of b", "message of neither"},
+		b.String():            {"message of a", "message of neither"},
+		unattributed.String(): {"message of a", "message of b"},
+	} {
+		for _, other := range others {
+			assert.NotContains(t, out, other)
+		}
+	}
+}
+
+// TestStateReferenceReadErrors checks that failed reads are reported with their
+// context, a stable reason and code, and a hint.
+func TestStateReferenceReadErrors(t *testing.T) {
+	InitTfBackend()
+
+	statusServer := func(code int) string {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(code)
+		}))
+		t.Cleanup(server.Close)
+		return server.URL
+	}
+	httpReference := func(address string) func() error {
+		return func() error {
+			_, err := (&GetHTTPReference{}).Invoke(t.Context(), infer.FunctionRequest[GetHTTPReferenceArgs]{
+				Input: GetHTTPReferenceArgs{Address: address, RetryMax: ptr(0)},
+			})
+			return err
+		}
+	}
+	// Functions check their arguments before reading, so invalid configurations are
+	// read through a Reference, whose arguments are checked separately.
+	backendReference := func(backendType string, config map[string]any) func() error {
+		return func() error {
+			_, err := ReferenceArgs{
+				BackendArgs: BackendArgs{
+					BackendType: backendType,
+					Config:      config,
+				},
+				WorkspaceArgs: WorkspaceArgs{Workspace: ptr(defaultWorkspace)},
+			}.read(t.Context())
+			return err
+		}
+	}
+	// A directory named like a status code fails the read with its name in the error,
+	// which is not a status.
+	statusNamedDir := filepath.Join(t.TempDir(), "401")
+	require.NoError(t, os.Mkdir(statusNamedDir, 0o700))
+
+	tests := []struct {
+		name      string
+		read      func() error
+		reason    shim.Reason
+		code      codes.Code
+		stage     shim.Stage
+		workspace string
+	}{
+		{
+			name:   "unsupported backend",
+			read:   backendReference("nonexistent", nil),
+			reason: shim.ReasonUnsupportedBackend,
+			code:   codes.InvalidArgument,
+			stage:  shim.StageCoerce,
+		},
+		{
+			name:   "invalid config",
+			read:   backendReference("local", map[string]any{"bucket": "state"}),
+			reason: shim.ReasonInvalidConfig,
+			code:   codes.InvalidArgument,
+			stage:  shim.StageCoerce,
+		},
+		{
+			name:      "empty state",
+			read:      httpReference(statusServer(http.StatusNotFound)),
+			reason:    shim.ReasonEmptyState,
+			code:      codes.NotFound,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+		{
+			name:      "unauthenticated",
+			read:      httpReference(statusServer(http.StatusUnauthorized)),
+			reason:    shim.ReasonUnauthenticated,
+			code:      codes.Unauthenticated,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+		{
+			name:      "access denied",
+			read:      httpReference(statusServer(http.StatusForbidden)),
+			reason:    shim.ReasonAccessDenied,
+			code:      codes.PermissionDenied,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+		{
+			name:      "status code in a path",
+			read:      backendReference("local", map[string]any{localPathAttribute: statusNamedDir}),
+			reason:    shim.ReasonReadFailed,
+			code:      codes.Internal,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+		{
+			name: "timeout",
+			read: func() error {
+				_, err := (&GetHTTPReference{}).Invoke(t.Context(), infer.FunctionRequest[GetHTTPReferenceArgs]{
+					Input: GetHTTPReferenceArgs{Address: hangingServer(t).URL, ReadArgs: ReadArgs{Timeout: ptr("100ms")}},
+				})
+				return err
+			},
+			reason:    shim.ReasonTimeout,
+			code:      codes.DeadlineExceeded,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+		{
+			name: "invalid timeout",
+			read: func() error {
+				_, err := (&GetHTTPReference{}).Invoke(t.Context(), infer.FunctionRequest[GetHTTPReferenceArgs]{
+					Input: GetHTTPReferenceArgs{Address: statusServer(http.StatusOK), ReadArgs: ReadArgs{Timeout: ptr("soon")}},
+				})
+				return err
+			},
+			reason: shim.ReasonInvalidArgument,
+			code:   codes.InvalidArgument,
+			stage:  shim.StageArguments,
+		},
+		{
+			name: "config set twice",
+			read: func() error {
+				_, err := (&BackendArgs{
+					BackendType:  "local",
+					Config:       map[string]any{localPathAttribute: "a"},
+					SecretConfig: map[string]any{localPathAttribute: "b"},
+				}).merge()
+				return err
+			},
+			reason: shim.ReasonInvalidArgument,
+			code:   codes.InvalidArgument,
+			stage:  shim.StageArguments,
+		},
+		{
+			name: "output not found",
+			read: func() error {
+				_, err := (&GetOutput{}).Invoke(t.Context(), infer.FunctionRequest[GetOutputArgs]{
+					Input: GetOutputArgs{
+						BackendArgs: BackendArgs{
+							BackendType: "local",
+							Config:      map[string]any{localPathAttribute: "testdata/test.tfstate"},
+						},
+						WorkspaceArgs: WorkspaceArgs{Workspace: ptr(defaultWorkspace)},
+						Name:          "nonexistent",
+					},
+				})
+				return err
+			},
+			reason:    shim.ReasonOutputNotFound,
+			code:      codes.NotFound,
+			stage:     shim.StageOutputs,
+			workspace: defaultWorkspace,
+		},
+		{
+			name: "workspace not found",
+			read: func() error {
+				_, err := ReferenceArgs{
+					BackendArgs: BackendArgs{
+						BackendType: "local",
+						Config:      map[string]any{"workspace_dir": t.TempDir()},
+					},
+					WorkspaceArgs: WorkspaceArgs{Workspace: ptr("missing")},
+				}.read(t.Context())
+				return err
+			},
+			reason:    shim.ReasonWorkspaceNotFound,
+			code:      codes.NotFound,
+			stage:     shim.StageWorkspaces,
+			workspace: "missing",
+		},
+		{
+			name:      "unavailable",
+			read:      httpReference("http://127.0.0.1:1/state"),
+			reason:    shim.ReasonUnavailable,
+			code:      codes.Unavailable,
+			stage:     shim.StageRefresh,
+			workspace: defaultWorkspace,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.read()
+
+			var readErr *shim.ReadError
+			require.ErrorAs(t, err, &readErr)
+			assert.Equal(t, tt.reason, readErr.Reason)
+			assert.Equal(t, tt.stage, readErr.Stage)
+			assert.Equal(t, tt.workspace, readErr.Workspace)
+
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), string(tt.reason))
+			assert.Contains(t, status.Convert(err).Message(), readErr.Hint())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform/shim"
+	"google.golang.org/grpc/status"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// defaultParallelism is the number of workspaces GetWorkspaceReferences reads at the
+// same time when parallelism is unset.
+const defaultParallelism = 4
+
+type GetWorkspaceReferences struct{}
+
+var (
+	_ = (infer.Annotated)((*GetWorkspaceReferences)(nil))
+	_ = (infer.ExplicitDependencies[GetWorkspaceReferencesArgs, GetWorkspaceReferencesResult])(
+		(*GetWorkspaceReferences)(nil))
+)
+
+func (r *GetWorkspaceReferences) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Access the state of several workspaces of any backend Terraform supports, configured "+
+		"as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.")
+}
+
+type GetWorkspaceReferencesArgs struct {
+	BackendArgs
+
+	Workspaces  []string `pulumi:"workspaces,optional"`
+	Prefix      *string  `pulumi:"prefix,optional"`
+	Regex       *string  `pulumi:"regex,optional"`
+	Parallelism *int     `pulumi:"parallelism,optional"`
+
+	OutputArgs
+	ReadArgs
+}
+
+func (r *GetWorkspaceReferencesArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Workspaces, "The workspaces to read. When unset, every workspace of the backend matching "+
+		"prefix and regex is read.")
+	a.Describe(&r.Prefix, "Only read the workspaces whose name starts with this prefix. Conflicts with "+
+		"workspaces.")
+	a.Describe(&r.Regex, "Only read the workspaces whose name matches this regular expression, in RE2 "+
+		"syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.")
+	a.Describe(&r.Parallelism, "The most workspaces read at the same time.")
+
+	a.SetDefault(&r.Parallelism, defaultParallelism)
+}
+
+type GetWorkspaceReferencesResult struct {
+	Workspaces map[string]StateReferenceOutputs `pulumi:"workspaces"`
+	Errors     map[string]string                `pulumi:"errors"`
+}
+
+func (r *GetWorkspaceReferencesResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Workspaces, "The state of each workspace read successfully, keyed by workspace name.")
+	a.Describe(&r.Errors, "The error reading each workspace that could not be read, keyed by workspace name.")
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the secretConfig (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *GetWorkspaceReferences) WireDependencies(
+	f infer.FieldSelector, _ *GetWorkspaceReferencesArgs, state *GetWorkspaceReferencesResult,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// check rejects filters alongside an explicit list of workspaces, which they would
+// not apply to, and validates the filters and the timeout, then the backend
+// configuration as the backend does before reading.
+func (r *GetWorkspaceReferencesArgs) check(ctx context.Context) []p.CheckFailure {
+	if failures := conflicts(
+		argument{name: "workspaces", set: r.Workspaces != nil},
+		argument{name: "prefix", set: r.Prefix != nil},
+		argument{name: "regex", set: r.Regex != nil},
+	); len(failures) > 0 {
+		return failures
+	}
+	if failures := append(checkWorkspaceFilter(r.Regex), r.ReadArgs.check()...); len(failures) > 0 {
+		return failures
+	}
+	return r.BackendArgs.check(ctx, r.Env)
+}
+
+func (r *GetWorkspaceReferences) Invoke(
+	ctx context.Context, req infer.FunctionRequest[GetWorkspaceReferencesArgs],
+) (infer.FunctionResponse[GetWorkspaceReferencesResult], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[GetWorkspaceReferencesResult]{}, err
+	}
+
+	include := workspaceFilter(args.Prefix, args.Regex)
+	config, err := args.merge()
+	if err != nil {
+		return infer.FunctionResponse[GetWorkspaceReferencesResult]{}, err
+	}
+	parallelism := defaultParallelism
+	if args.Parallelism != nil {
+		parallelism = *args.Parallelism
+	}
+	ctx, cancel, err := withTimeout(ctx, args.Timeout)
+	if err != nil {
+		return infer.FunctionResponse[GetWorkspaceReferencesResult]{}, argumentError(args.BackendType, err)
+	}
+	defer cancel()
+
+	cfg := providerConfig(ctx)
+	config = cfg.withBackendDefaults(args.BackendType, config)
+	states, errs, err := shim.StateReferenceReadWorkspaces(ctx, args.BackendType, config, cfg.environment(args.Env),
+		shim.ReadWorkspacesOptions{
+			Workspaces:  args.Workspaces,
+			Include:     include,
+			Parallelism: parallelism,
+		})
+	if err != nil {
+		return infer.FunctionResponse[GetWorkspaceReferencesResult]{}, err
+	}
+
+	result := GetWorkspaceReferencesResult{
+		Workspaces: make(map[string]StateReferenceOutputs, len(states)),
+		Errors:     make(map[string]string, len(errs)),
+	}
+	opts := args.readOptions(args.OutputArgs)
+	for w, state := range states {
+		outputs, err := stateReferenceOutputs(args.BackendType, w, state, opts)
+		if err != nil {
+			result.Errors[w] = err.Error()
+			continue
+		}
+		result.Workspaces[w] = outputs
+	}
+	for w, err := range errs {
+		// The shim reports errors as gRPC statuses, which only add noise here.
+		result.Errors[w] = status.Convert(err).Message()
+	}
+	return infer.FunctionResponse[GetWorkspaceReferencesResult]{Output: result}, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+func TestGetWorkspaceReferences(t *testing.T) {
+	workspaceDir := t.TempDir()
+	for w, src := range map[string]string{
+		"network-eu": "testdata/test.tfstate",
+		"network-us": "testdata/test.tfstate",
+		"database":   "testdata/sensitive.tfstate",
+		"staging":    "testdata/test.tfstate",
+	} {
+		state, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, os.Mkdir(filepath.Join(workspaceDir, w), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(workspaceDir, w, "terraform.tfstate"), state, 0o600))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(workspaceDir, "network-broken"), 0o700))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(workspaceDir, "network-broken", "terraform.tfstate"), []byte("{"), 0o600))
+
+	outputs := StateReferenceOutputs{
+		Outputs:            map[string]any{"greeting": "hello", "count": 42},
+		Serial:             1,
+		Lineage:            "test-lineage",
+		TerraformVersion:   ptr("1.5.7"),
+		StateFormatVersion: ptr(4),
+	}
+
+	tests := []struct {
+		name           string
+		args           GetWorkspaceReferencesArgs
+		wantWorkspaces map[string]StateReferenceOutputs
+		wantErrors     []string
+	}{
+		{
+			name: "prefix",
+			args: GetWorkspaceReferencesArgs{Prefix: ptr("network-")},
+			wantWorkspaces: map[string]StateReferenceOutputs{
+				"network-eu": outputs,
+				"network-us": outputs,
+			},
+			wantErrors: []string{"network-broken"},
+		},
+		{
+			name: "explicit workspaces with required outputs",
+			args: GetWorkspaceReferencesArgs{
+				Workspaces: []string{"staging", "database"},
+				OutputArgs: OutputArgs{RequiredOutputs: []string{"greeting"}},
+			},
+			wantWorkspaces: map[string]StateReferenceOutputs{"staging": outputs},
+			wantErrors:     []string{"database"},
+		},
+		{
+			name: "regex with a single worker",
+			args: GetWorkspaceReferencesArgs{Regex: ptr("^(staging|network-eu)$"), Parallelism: ptr(1)},
+			wantWorkspaces: map[string]StateReferenceOutputs{
+				"network-eu": outputs,
+				"staging":    outputs,
+			},
+		},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.BackendType = "local"
+			tt.args.Config = map[string]any{"workspace_dir": workspaceDir}
+
+			resp, err := (&GetWorkspaceReferences{}).Invoke(t.Context(),
+				infer.FunctionRequest[GetWorkspaceReferencesArgs]{Input: tt.args})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantWorkspaces, resp.Output.Workspaces)
+			var failed []string
+			for w, msg := range resp.Output.Errors {
+				failed = append(failed, w)
+				assert.NotEmpty(t, msg)
+			}
+			assert.ElementsMatch(t, tt.wantErrors, failed)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/shim"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type ListWorkspaces struct{}
+
+var (
+	_ = (infer.Annotated)((*ListWorkspaces)(nil))
+	_ = (infer.ExplicitDependencies[ListWorkspacesArgs, ListWorkspacesResult])((*ListWorkspaces)(nil))
+)
+
+func (r *ListWorkspaces) Annotate(a infer.Annotator) {
+	a.Describe(&r, "List the workspaces of any backend Terraform supports, configured with the same "+
+		"arguments as the backend block in Terraform.")
+}
+
+type ListWorkspacesArgs struct {
+	BackendArgs
+
+	Prefix *string `pulumi:"prefix,optional"`
+	Regex  *string `pulumi:"regex,optional"`
+
+	ReadArgs
+}
+
+func (r *ListWorkspacesArgs) Annotate(a infer.Annotator) {
+	a.Describe(&r.Prefix, "Only list the workspaces whose name starts with this prefix.")
+	a.Describe(&r.Regex, "Only list the workspaces whose name matches this regular expression, in RE2 "+
+		"syntax. The expression is not anchored, so use ^ and $ to match whole names.")
+}
+
+type ListWorkspacesResult struct {
+	Workspaces []string `pulumi:"workspaces"`
+}
+
+var _ = (infer.Annotated)((*ListWorkspacesResult)(nil))
+
+func (r *ListWorkspacesResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Workspaces, "The names of the workspaces, sorted. They can be passed as the workspace "+
+		"argument of the state reference functions.")
+}
+
+// WireDependencies lets us tell users that our outputs shouldn't be secret, even when
+// the secretConfig (when provided) is always secret.
+//
+// TODO[https://github.com/pulumi/pulumi-go-provider/issues/323]: This doesn't currently
+// work; [infer.ExplicitDependencies] is not currently implemented for [infer] based
+// functions.
+func (r *ListWorkspaces) WireDependencies(
+	f infer.FieldSelector, _ *ListWorkspacesArgs, state *ListWorkspacesResult,
+) {
+	f.OutputField(&state).NeverSecret() // The output should never be secret by default
+}
+
+// workspaceFilter returns a function reporting whether a workspace name starts with
+// prefix and matches regex. Unset arguments match every name. regex must have been
+// checked by checkWorkspaceFilter.
+func workspaceFilter(prefix, regex *string) func(string) bool {
+	var re *regexp.Regexp
+	if regex != nil {
+		re = regexp.MustCompile(*regex)
+	}
+	return func(w string) bool {
+		return (prefix == nil || strings.HasPrefix(w, *prefix)) && (re == nil || re.MatchString(w))
+	}
+}
+
+// checkWorkspaceFilter checks that regex compiles.
+func checkWorkspaceFilter(regex *string) []p.CheckFailure {
+	if regex == nil {
+		return nil
+	}
+	if _, err := regexp.Compile(*regex); err != nil {
+		return []p.CheckFailure{{Property: "regex", Reason: fmt.Sprintf("invalid regular expression: %v", err)}}
+	}
+	return nil
+}
+
+// check validates the filters and the timeout, then the backend configuration as the
+// backend does before reading.
+func (r *ListWorkspacesArgs) check(ctx context.Context) []p.CheckFailure {
+	if failures := append(checkWorkspaceFilter(r.Regex), r.ReadArgs.check()...); len(failures) > 0 {
+		return failures
+	}
+	return r.BackendArgs.check(ctx, r.Env)
+}
+
+func (r *ListWorkspaces) Invoke(
+	ctx context.Context, req infer.FunctionRequest[ListWorkspacesArgs],
+) (infer.FunctionResponse[ListWorkspacesResult], error) {
+	args := req.Input
+	ctx, err := prepare(ctx, &args)
+	if err != nil {
+		return infer.FunctionResponse[ListWorkspacesResult]{}, err
+	}
+
+	include := workspaceFilter(args.Prefix, args.Regex)
+	config, err := args.merge()
+	if err != nil {
+		return infer.FunctionResponse[ListWorkspacesResult]{}, err
+	}
+	ctx, cancel, err := withTimeout(ctx, args.Timeout)
+	if err != nil {
+		return infer.FunctionResponse[ListWorkspacesResult]{}, argumentError(args.BackendType, err)
+	}
+	defer cancel()
+	cfg := providerConfig(ctx)
+	config = cfg.withBackendDefaults(args.BackendType, config)
+	all, err := shim.Workspaces(ctx, args.BackendType, config, cfg.environment(args.Env))
+	if err != nil {
+		return infer.FunctionResponse[ListWorkspacesResult]{}, err
+	}
+
+	workspaces := []string{}
+	for _, w := range all {
+		if include(w) {
+			workspaces = append(workspaces, w)
+		}
+	}
+	return infer.FunctionResponse[ListWorkspacesResult]{Output: ListWorkspacesResult{workspaces}}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+func TestListWorkspaces(t *testing.T) {
+	workspaceDir := t.TempDir()
+	for _, w := range []string{"prod-us", "staging", "prod-eu"} {
+		require.NoError(t, os.Mkdir(filepath.Join(workspaceDir, w), 0o700))
+	}
+
+	tests := []struct {
+		name    string
+		prefix  *string
+		regex   *string
+		want    []string
+		wantErr string
+	}{
+		{name: "all", want: []string{"default", "prod-eu", "prod-us", "staging"}},
+		{name: "prefix", prefix: ptr("prod-"), want: []string{"prod-eu", "prod-us"}},
+		{name: "regex", regex: ptr("^(default|staging)$"), want: []string{"default", "staging"}},
+		{name: "prefix and regex", prefix: ptr("prod-"), regex: ptr("us$"), want: []string{"prod-us"}},
+		{name: "no match", prefix: ptr("dev-"), want: []string{}},
+		{name: "invalid regex", regex: ptr("("), wantErr: "regex: invalid regular expression"},
+	}
+
+	InitTfBackend()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := (&ListWorkspaces{}).Invoke(t.Context(), infer.FunctionRequest[ListWorkspacesArgs]{
+				Input: ListWorkspacesArgs{
+					BackendArgs: BackendArgs{
+						BackendType: "local",
+						Config:      map[string]any{"workspace_dir": workspaceDir},
+					},
+					Prefix: tt.prefix,
+					Regex:  tt.regex,
+				},
+			})
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.Output.Workspaces)
+		})
+	}
+}
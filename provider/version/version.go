@@ -20,7 +20,17 @@ import (
 	"github.com/blang/semver"
 )
 
-var Version semver.Version = semver.MustParse(strings.TrimPrefix(version, "v"))
+// Version is the semver of this build, or the zero version for builds not linked with
+// one, as under go test.
+var Version semver.Version = parse(version)
 
-// Version is initialized by the Go linker to contain the semver of this build.
+// parse parses the version the build was linked with, which is empty when it was not.
+func parse(version string) semver.Version {
+	if version == "" {
+		return semver.Version{}
+	}
+	return semver.MustParse(strings.TrimPrefix(version, "v"))
+}
+
+// version is set by the Go linker to the semver of this build.
 var version string
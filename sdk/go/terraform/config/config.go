@@ -0,0 +1,69 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+func GetAzurermDefaults(ctx *pulumi.Context) string {
+	return config.Get(ctx, "terraform:azurermDefaults")
+}
+
+// Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+func GetCacheStateReads(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "terraform:cacheStateReads")
+}
+
+// How long a cached state is reused, as a duration such as 30s or 5m.
+func GetCacheTtl(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "terraform:cacheTtl")
+	if err == nil {
+		return v
+	}
+	var value string
+	value = "5m"
+	return value
+}
+
+// Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+func GetDisableEnvFallback(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "terraform:disableEnvFallback")
+}
+
+// Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+func GetGcsDefaults(ctx *pulumi.Context) string {
+	return config.Get(ctx, "terraform:gcsDefaults")
+}
+
+// Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+func GetRemoteDefaults(ctx *pulumi.Context) string {
+	return config.Get(ctx, "terraform:remoteDefaults")
+}
+
+// Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+func GetS3Defaults(ctx *pulumi.Context) string {
+	return config.Get(ctx, "terraform:s3Defaults")
+}
+
+// Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+func GetShowTerraformLogs(ctx *pulumi.Context) bool {
+	v, err := config.TryBool(ctx, "terraform:showTerraformLogs")
+	if err == nil {
+		return v
+	}
+	var value bool
+	value = true
+	return value
+}
+
+// The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+func GetTimeout(ctx *pulumi.Context) string {
+	return config.Get(ctx, "terraform:timeout")
+}
@@ -8,11 +8,17 @@ import (
 	"reflect"
 
 	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/state"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
 type Provider struct {
 	pulumi.ProviderResourceState
+
+	// How long a cached state is reused, as a duration such as 30s or 5m.
+	CacheTtl pulumi.StringPtrOutput `pulumi:"cacheTtl"`
+	// The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+	Timeout pulumi.StringPtrOutput `pulumi:"timeout"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -22,6 +28,12 @@ func NewProvider(ctx *pulumi.Context,
 		args = &ProviderArgs{}
 	}
 
+	if args.CacheTtl == nil {
+		args.CacheTtl = pulumi.StringPtr("5m")
+	}
+	if args.ShowTerraformLogs == nil {
+		args.ShowTerraformLogs = pulumi.BoolPtr(true)
+	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:terraform", name, args, &resource, opts...)
@@ -32,10 +44,46 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	AzurermDefaults *state.AzureRMDefaults `pulumi:"azurermDefaults"`
+	// Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+	CacheStateReads *bool `pulumi:"cacheStateReads"`
+	// How long a cached state is reused, as a duration such as 30s or 5m.
+	CacheTtl *string `pulumi:"cacheTtl"`
+	// Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+	DisableEnvFallback *bool `pulumi:"disableEnvFallback"`
+	// Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	GcsDefaults *state.GcsDefaults `pulumi:"gcsDefaults"`
+	// Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+	RemoteDefaults *state.RemoteDefaults `pulumi:"remoteDefaults"`
+	// Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	S3Defaults *state.S3Defaults `pulumi:"s3Defaults"`
+	// Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+	ShowTerraformLogs *bool `pulumi:"showTerraformLogs"`
+	// The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+	Timeout *string `pulumi:"timeout"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	AzurermDefaults state.AzureRMDefaultsPtrInput
+	// Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+	CacheStateReads pulumi.BoolPtrInput
+	// How long a cached state is reused, as a duration such as 30s or 5m.
+	CacheTtl pulumi.StringPtrInput
+	// Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+	DisableEnvFallback pulumi.BoolPtrInput
+	// Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	GcsDefaults state.GcsDefaultsPtrInput
+	// Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+	RemoteDefaults state.RemoteDefaultsPtrInput
+	// Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+	S3Defaults state.S3DefaultsPtrInput
+	// Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+	ShowTerraformLogs pulumi.BoolPtrInput
+	// The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+	Timeout pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -75,6 +123,16 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+// How long a cached state is reused, as a duration such as 30s or 5m.
+func (o ProviderOutput) CacheTtl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.CacheTtl }).(pulumi.StringPtrOutput)
+}
+
+// The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+func (o ProviderOutput) Timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Timeout }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
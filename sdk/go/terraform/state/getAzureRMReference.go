@@ -37,8 +37,12 @@ type GetAzureRMReferenceArgs struct {
 	ContainerName string `pulumi:"containerName"`
 	// A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
 	Endpoint *string `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
 	// The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
 	Environment *string `pulumi:"environment"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
 	// The name of the blob holding the Terraform state file inside the storage container.
 	Key string `pulumi:"key"`
 	// The hostname of the Azure metadata service used to obtain the cloud environment. Falls back to the ARM_METADATA_HOST environment variable when unset.
@@ -53,8 +57,14 @@ type GetAzureRMReferenceArgs struct {
 	OidcToken *string `pulumi:"oidcToken"`
 	// The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
 	OidcTokenFilePath *string `pulumi:"oidcTokenFilePath"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
 	// The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
 	ResourceGroupName *string `pulumi:"resourceGroupName"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
 	// A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
 	SasToken *string `pulumi:"sasToken"`
 	// The name of the storage account.
@@ -63,6 +73,8 @@ type GetAzureRMReferenceArgs struct {
 	SubscriptionId *string `pulumi:"subscriptionId"`
 	// The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
 	TenantId *string `pulumi:"tenantId"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
 	// Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
 	UseAzureadAuth *bool `pulumi:"useAzureadAuth"`
 	// Whether to authenticate using Managed Service Identity. Falls back to the ARM_USE_MSI environment variable when unset.
@@ -88,8 +100,18 @@ func (val *GetAzureRMReferenceArgs) Defaults() *GetAzureRMReferenceArgs {
 
 // The result of fetching from a Terraform state store.
 type GetAzureRMReferenceResult struct {
-	// The outputs displayed from Terraform state.
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
 }
 
 func GetAzureRMReferenceOutput(ctx *pulumi.Context, args GetAzureRMReferenceOutputArgs, opts ...pulumi.InvokeOption) GetAzureRMReferenceResultOutput {
@@ -116,8 +138,12 @@ type GetAzureRMReferenceOutputArgs struct {
 	ContainerName pulumi.StringInput `pulumi:"containerName"`
 	// A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
 	Endpoint pulumi.StringPtrInput `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
 	// The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
 	Environment pulumi.StringPtrInput `pulumi:"environment"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
 	// The name of the blob holding the Terraform state file inside the storage container.
 	Key pulumi.StringInput `pulumi:"key"`
 	// The hostname of the Azure metadata service used to obtain the cloud environment. Falls back to the ARM_METADATA_HOST environment variable when unset.
@@ -132,8 +158,14 @@ type GetAzureRMReferenceOutputArgs struct {
 	OidcToken pulumi.StringPtrInput `pulumi:"oidcToken"`
 	// The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
 	OidcTokenFilePath pulumi.StringPtrInput `pulumi:"oidcTokenFilePath"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
 	// The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
 	ResourceGroupName pulumi.StringPtrInput `pulumi:"resourceGroupName"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
 	// A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
 	SasToken pulumi.StringPtrInput `pulumi:"sasToken"`
 	// The name of the storage account.
@@ -142,6 +174,8 @@ type GetAzureRMReferenceOutputArgs struct {
 	SubscriptionId pulumi.StringPtrInput `pulumi:"subscriptionId"`
 	// The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
 	TenantId pulumi.StringPtrInput `pulumi:"tenantId"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
 	// Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
 	UseAzureadAuth pulumi.BoolPtrInput `pulumi:"useAzureadAuth"`
 	// Whether to authenticate using Managed Service Identity. Falls back to the ARM_USE_MSI environment variable when unset.
@@ -171,11 +205,36 @@ func (o GetAzureRMReferenceResultOutput) ToGetAzureRMReferenceResultOutputWithCo
 	return o
 }
 
-// The outputs displayed from Terraform state.
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetAzureRMReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetAzureRMReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 func (o GetAzureRMReferenceResultOutput) Outputs() pulumi.MapOutput {
 	return o.ApplyT(func(v GetAzureRMReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
 }
 
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetAzureRMReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetAzureRMReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetAzureRMReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetAzureRMReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetAzureRMReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetAzureRMReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetAzureRMReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetAzureRMReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterOutputType(GetAzureRMReferenceResultOutput{})
 }
@@ -0,0 +1,160 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state from any backend Terraform supports, configured with the same arguments as the backend block in Terraform. Prefer the typed functions, such as getS3Reference, when one exists for the backend.
+func GetBackendReference(ctx *pulumi.Context, args *GetBackendReferenceArgs, opts ...pulumi.InvokeOption) (*GetBackendReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetBackendReferenceResult
+	err := ctx.Invoke("terraform:state:getBackendReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetBackendReferenceArgs struct {
+	// The type of the backend, as in the label of the backend block, e.g. s3.
+	BackendType string `pulumi:"backendType"`
+	// The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+	Config map[string]interface{} `pulumi:"config"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+	SecretConfig map[string]interface{} `pulumi:"secretConfig"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetBackendReferenceArgs
+func (val *GetBackendReferenceArgs) Defaults() *GetBackendReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetBackendReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetBackendReferenceOutput(ctx *pulumi.Context, args GetBackendReferenceOutputArgs, opts ...pulumi.InvokeOption) GetBackendReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetBackendReferenceResultOutput, error) {
+			args := v.(GetBackendReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getBackendReference", args.Defaults(), GetBackendReferenceResultOutput{}, options).(GetBackendReferenceResultOutput), nil
+		}).(GetBackendReferenceResultOutput)
+}
+
+type GetBackendReferenceOutputArgs struct {
+	// The type of the backend, as in the label of the backend block, e.g. s3.
+	BackendType pulumi.StringInput `pulumi:"backendType"`
+	// The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+	Config pulumi.MapInput `pulumi:"config"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+	SecretConfig pulumi.MapInput `pulumi:"secretConfig"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetBackendReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetBackendReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetBackendReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetBackendReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetBackendReferenceResult)(nil)).Elem()
+}
+
+func (o GetBackendReferenceResultOutput) ToGetBackendReferenceResultOutput() GetBackendReferenceResultOutput {
+	return o
+}
+
+func (o GetBackendReferenceResultOutput) ToGetBackendReferenceResultOutputWithContext(ctx context.Context) GetBackendReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetBackendReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetBackendReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetBackendReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetBackendReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetBackendReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetBackendReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetBackendReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetBackendReferenceResultOutput{})
+}
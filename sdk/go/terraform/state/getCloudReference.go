@@ -0,0 +1,151 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in HCP Terraform or Terraform Enterprise, as configured by a cloud block.
+func GetCloudReference(ctx *pulumi.Context, args *GetCloudReferenceArgs, opts ...pulumi.InvokeOption) (*GetCloudReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetCloudReferenceResult
+	err := ctx.Invoke("terraform:state:getCloudReference", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetCloudReferenceArgs struct {
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// The hostname of HCP Terraform or Terraform Enterprise. Falls back to the TF_CLOUD_HOSTNAME environment variable, and then to app.terraform.io, when unset.
+	Hostname *string `pulumi:"hostname"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The name of the organization containing the targeted workspace. Falls back to the TF_CLOUD_ORGANIZATION environment variable when unset.
+	Organization *string `pulumi:"organization"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The token used to authenticate with HCP Terraform or Terraform Enterprise.
+	Token *string `pulumi:"token"`
+	// The name of the workspace to read, required when workspaces are selected by tags. Ignored when workspaces.name is set.
+	Workspace *string `pulumi:"workspace"`
+	// The workspaces the cloud block maps to.
+	Workspaces CloudWorkspaces `pulumi:"workspaces"`
+}
+
+// The result of fetching from a Terraform state store.
+type GetCloudReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetCloudReferenceOutput(ctx *pulumi.Context, args GetCloudReferenceOutputArgs, opts ...pulumi.InvokeOption) GetCloudReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetCloudReferenceResultOutput, error) {
+			args := v.(GetCloudReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getCloudReference", args, GetCloudReferenceResultOutput{}, options).(GetCloudReferenceResultOutput), nil
+		}).(GetCloudReferenceResultOutput)
+}
+
+type GetCloudReferenceOutputArgs struct {
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// The hostname of HCP Terraform or Terraform Enterprise. Falls back to the TF_CLOUD_HOSTNAME environment variable, and then to app.terraform.io, when unset.
+	Hostname pulumi.StringPtrInput `pulumi:"hostname"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The name of the organization containing the targeted workspace. Falls back to the TF_CLOUD_ORGANIZATION environment variable when unset.
+	Organization pulumi.StringPtrInput `pulumi:"organization"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The token used to authenticate with HCP Terraform or Terraform Enterprise.
+	Token pulumi.StringPtrInput `pulumi:"token"`
+	// The name of the workspace to read, required when workspaces are selected by tags. Ignored when workspaces.name is set.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+	// The workspaces the cloud block maps to.
+	Workspaces CloudWorkspacesInput `pulumi:"workspaces"`
+}
+
+func (GetCloudReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCloudReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetCloudReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetCloudReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCloudReferenceResult)(nil)).Elem()
+}
+
+func (o GetCloudReferenceResultOutput) ToGetCloudReferenceResultOutput() GetCloudReferenceResultOutput {
+	return o
+}
+
+func (o GetCloudReferenceResultOutput) ToGetCloudReferenceResultOutputWithContext(ctx context.Context) GetCloudReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetCloudReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetCloudReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetCloudReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetCloudReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetCloudReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetCloudReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetCloudReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetCloudReferenceResultOutput{})
+}
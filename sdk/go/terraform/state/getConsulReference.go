@@ -0,0 +1,184 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in the Consul KV store.
+func GetConsulReference(ctx *pulumi.Context, args *GetConsulReferenceArgs, opts ...pulumi.InvokeOption) (*GetConsulReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetConsulReferenceResult
+	err := ctx.Invoke("terraform:state:getConsulReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetConsulReferenceArgs struct {
+	// An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+	AccessToken *string `pulumi:"accessToken"`
+	// The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+	Address *string `pulumi:"address"`
+	// The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+	CaFile *string `pulumi:"caFile"`
+	// The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+	CertFile *string `pulumi:"certFile"`
+	// The datacenter to read from. Defaults to the datacenter of the agent.
+	Datacenter *string `pulumi:"datacenter"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+	HttpAuth *string `pulumi:"httpAuth"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+	KeyFile *string `pulumi:"keyFile"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+	Path string `pulumi:"path"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+	Scheme *string `pulumi:"scheme"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetConsulReferenceArgs
+func (val *GetConsulReferenceArgs) Defaults() *GetConsulReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetConsulReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetConsulReferenceOutput(ctx *pulumi.Context, args GetConsulReferenceOutputArgs, opts ...pulumi.InvokeOption) GetConsulReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetConsulReferenceResultOutput, error) {
+			args := v.(GetConsulReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getConsulReference", args.Defaults(), GetConsulReferenceResultOutput{}, options).(GetConsulReferenceResultOutput), nil
+		}).(GetConsulReferenceResultOutput)
+}
+
+type GetConsulReferenceOutputArgs struct {
+	// An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+	AccessToken pulumi.StringPtrInput `pulumi:"accessToken"`
+	// The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+	Address pulumi.StringPtrInput `pulumi:"address"`
+	// The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+	CaFile pulumi.StringPtrInput `pulumi:"caFile"`
+	// The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+	CertFile pulumi.StringPtrInput `pulumi:"certFile"`
+	// The datacenter to read from. Defaults to the datacenter of the agent.
+	Datacenter pulumi.StringPtrInput `pulumi:"datacenter"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+	HttpAuth pulumi.StringPtrInput `pulumi:"httpAuth"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+	KeyFile pulumi.StringPtrInput `pulumi:"keyFile"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+	Path pulumi.StringInput `pulumi:"path"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+	Scheme pulumi.StringPtrInput `pulumi:"scheme"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetConsulReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetConsulReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetConsulReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetConsulReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetConsulReferenceResult)(nil)).Elem()
+}
+
+func (o GetConsulReferenceResultOutput) ToGetConsulReferenceResultOutput() GetConsulReferenceResultOutput {
+	return o
+}
+
+func (o GetConsulReferenceResultOutput) ToGetConsulReferenceResultOutputWithContext(ctx context.Context) GetConsulReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetConsulReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetConsulReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetConsulReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetConsulReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetConsulReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetConsulReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetConsulReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetConsulReferenceResultOutput{})
+}
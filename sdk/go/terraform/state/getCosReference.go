@@ -0,0 +1,188 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in a Tencent Cloud Object Storage (COS) bucket.
+func GetCosReference(ctx *pulumi.Context, args *GetCosReferenceArgs, opts ...pulumi.InvokeOption) (*GetCosReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetCosReferenceResult
+	err := ctx.Invoke("terraform:state:getCosReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetCosReferenceArgs struct {
+	// Whether to read through the global acceleration endpoint of the bucket.
+	Accelerate *bool `pulumi:"accelerate"`
+	// A CAM role to assume in order to read the state.
+	AssumeRole *CosAssumeRole `pulumi:"assumeRole"`
+	// The name of the COS bucket, in the form <name>-<appid>.
+	Bucket string `pulumi:"bucket"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+	Key *string `pulumi:"key"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The directory in the bucket holding the state file.
+	Prefix *string `pulumi:"prefix"`
+	// The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+	Region *string `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+	SecretId *string `pulumi:"secretId"`
+	// Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+	SecretKey *string `pulumi:"secretKey"`
+	// The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+	SecurityToken *string `pulumi:"securityToken"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetCosReferenceArgs
+func (val *GetCosReferenceArgs) Defaults() *GetCosReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Key == nil {
+		key_ := "terraform.tfstate"
+		tmp.Key = &key_
+	}
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetCosReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetCosReferenceOutput(ctx *pulumi.Context, args GetCosReferenceOutputArgs, opts ...pulumi.InvokeOption) GetCosReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetCosReferenceResultOutput, error) {
+			args := v.(GetCosReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getCosReference", args.Defaults(), GetCosReferenceResultOutput{}, options).(GetCosReferenceResultOutput), nil
+		}).(GetCosReferenceResultOutput)
+}
+
+type GetCosReferenceOutputArgs struct {
+	// Whether to read through the global acceleration endpoint of the bucket.
+	Accelerate pulumi.BoolPtrInput `pulumi:"accelerate"`
+	// A CAM role to assume in order to read the state.
+	AssumeRole CosAssumeRolePtrInput `pulumi:"assumeRole"`
+	// The name of the COS bucket, in the form <name>-<appid>.
+	Bucket pulumi.StringInput `pulumi:"bucket"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+	Key pulumi.StringPtrInput `pulumi:"key"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The directory in the bucket holding the state file.
+	Prefix pulumi.StringPtrInput `pulumi:"prefix"`
+	// The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+	SecretId pulumi.StringPtrInput `pulumi:"secretId"`
+	// Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+	SecretKey pulumi.StringPtrInput `pulumi:"secretKey"`
+	// The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+	SecurityToken pulumi.StringPtrInput `pulumi:"securityToken"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetCosReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCosReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetCosReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetCosReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetCosReferenceResult)(nil)).Elem()
+}
+
+func (o GetCosReferenceResultOutput) ToGetCosReferenceResultOutput() GetCosReferenceResultOutput {
+	return o
+}
+
+func (o GetCosReferenceResultOutput) ToGetCosReferenceResultOutputWithContext(ctx context.Context) GetCosReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetCosReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetCosReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetCosReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetCosReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetCosReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetCosReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetCosReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetCosReferenceResultOutput{})
+}
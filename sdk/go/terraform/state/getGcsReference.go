@@ -0,0 +1,184 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in a Google Cloud Storage bucket.
+func GetGcsReference(ctx *pulumi.Context, args *GetGcsReferenceArgs, opts ...pulumi.InvokeOption) (*GetGcsReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetGcsReferenceResult
+	err := ctx.Invoke("terraform:state:getGcsReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetGcsReferenceArgs struct {
+	// A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+	AccessToken *string `pulumi:"accessToken"`
+	// The name of the GCS bucket.
+	Bucket string `pulumi:"bucket"`
+	// The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+	Credentials *string `pulumi:"credentials"`
+	// The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+	EncryptionKey *string `pulumi:"encryptionKey"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+	ImpersonateServiceAccount *string `pulumi:"impersonateServiceAccount"`
+	// The delegation chain for impersonating impersonateServiceAccount.
+	ImpersonateServiceAccountDelegates []string `pulumi:"impersonateServiceAccountDelegates"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+	KmsEncryptionKey *string `pulumi:"kmsEncryptionKey"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+	Prefix *string `pulumi:"prefix"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+	StorageCustomEndpoint *string `pulumi:"storageCustomEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetGcsReferenceArgs
+func (val *GetGcsReferenceArgs) Defaults() *GetGcsReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetGcsReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetGcsReferenceOutput(ctx *pulumi.Context, args GetGcsReferenceOutputArgs, opts ...pulumi.InvokeOption) GetGcsReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetGcsReferenceResultOutput, error) {
+			args := v.(GetGcsReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getGcsReference", args.Defaults(), GetGcsReferenceResultOutput{}, options).(GetGcsReferenceResultOutput), nil
+		}).(GetGcsReferenceResultOutput)
+}
+
+type GetGcsReferenceOutputArgs struct {
+	// A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+	AccessToken pulumi.StringPtrInput `pulumi:"accessToken"`
+	// The name of the GCS bucket.
+	Bucket pulumi.StringInput `pulumi:"bucket"`
+	// The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+	Credentials pulumi.StringPtrInput `pulumi:"credentials"`
+	// The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+	EncryptionKey pulumi.StringPtrInput `pulumi:"encryptionKey"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+	ImpersonateServiceAccount pulumi.StringPtrInput `pulumi:"impersonateServiceAccount"`
+	// The delegation chain for impersonating impersonateServiceAccount.
+	ImpersonateServiceAccountDelegates pulumi.StringArrayInput `pulumi:"impersonateServiceAccountDelegates"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+	KmsEncryptionKey pulumi.StringPtrInput `pulumi:"kmsEncryptionKey"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+	Prefix pulumi.StringPtrInput `pulumi:"prefix"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+	StorageCustomEndpoint pulumi.StringPtrInput `pulumi:"storageCustomEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetGcsReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetGcsReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetGcsReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetGcsReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetGcsReferenceResult)(nil)).Elem()
+}
+
+func (o GetGcsReferenceResultOutput) ToGetGcsReferenceResultOutput() GetGcsReferenceResultOutput {
+	return o
+}
+
+func (o GetGcsReferenceResultOutput) ToGetGcsReferenceResultOutputWithContext(ctx context.Context) GetGcsReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetGcsReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetGcsReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetGcsReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetGcsReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetGcsReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetGcsReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetGcsReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetGcsReferenceResultOutput{})
+}
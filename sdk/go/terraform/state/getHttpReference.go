@@ -0,0 +1,171 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state served by a REST endpoint through the http backend.
+func GetHttpReference(ctx *pulumi.Context, args *GetHttpReferenceArgs, opts ...pulumi.InvokeOption) (*GetHttpReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetHttpReferenceResult
+	err := ctx.Invoke("terraform:state:getHttpReference", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetHttpReferenceArgs struct {
+	// The address of the REST endpoint serving the state.
+	Address string `pulumi:"address"`
+	// A PEM-encoded CA certificate chain used to verify the server certificate.
+	ClientCaCertificatePem *string `pulumi:"clientCaCertificatePem"`
+	// A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+	ClientCertificatePem *string `pulumi:"clientCertificatePem"`
+	// The PEM-encoded private key of clientCertificatePem.
+	ClientPrivateKeyPem *string `pulumi:"clientPrivateKeyPem"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+	Password *string `pulumi:"password"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// The number of HTTP request retries. Defaults to 2.
+	RetryMax *int `pulumi:"retryMax"`
+	// The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+	RetryWaitMax *int `pulumi:"retryWaitMax"`
+	// The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+	RetryWaitMin *int `pulumi:"retryWaitMin"`
+	// Whether to skip verification of the server's TLS certificate.
+	SkipCertVerification *bool `pulumi:"skipCertVerification"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+	Username *string `pulumi:"username"`
+}
+
+// The result of fetching from a Terraform state store.
+type GetHttpReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetHttpReferenceOutput(ctx *pulumi.Context, args GetHttpReferenceOutputArgs, opts ...pulumi.InvokeOption) GetHttpReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetHttpReferenceResultOutput, error) {
+			args := v.(GetHttpReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getHttpReference", args, GetHttpReferenceResultOutput{}, options).(GetHttpReferenceResultOutput), nil
+		}).(GetHttpReferenceResultOutput)
+}
+
+type GetHttpReferenceOutputArgs struct {
+	// The address of the REST endpoint serving the state.
+	Address pulumi.StringInput `pulumi:"address"`
+	// A PEM-encoded CA certificate chain used to verify the server certificate.
+	ClientCaCertificatePem pulumi.StringPtrInput `pulumi:"clientCaCertificatePem"`
+	// A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+	ClientCertificatePem pulumi.StringPtrInput `pulumi:"clientCertificatePem"`
+	// The PEM-encoded private key of clientCertificatePem.
+	ClientPrivateKeyPem pulumi.StringPtrInput `pulumi:"clientPrivateKeyPem"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// The number of HTTP request retries. Defaults to 2.
+	RetryMax pulumi.IntPtrInput `pulumi:"retryMax"`
+	// The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+	RetryWaitMax pulumi.IntPtrInput `pulumi:"retryWaitMax"`
+	// The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+	RetryWaitMin pulumi.IntPtrInput `pulumi:"retryWaitMin"`
+	// Whether to skip verification of the server's TLS certificate.
+	SkipCertVerification pulumi.BoolPtrInput `pulumi:"skipCertVerification"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+	Username pulumi.StringPtrInput `pulumi:"username"`
+}
+
+func (GetHttpReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetHttpReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetHttpReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetHttpReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetHttpReferenceResult)(nil)).Elem()
+}
+
+func (o GetHttpReferenceResultOutput) ToGetHttpReferenceResultOutput() GetHttpReferenceResultOutput {
+	return o
+}
+
+func (o GetHttpReferenceResultOutput) ToGetHttpReferenceResultOutputWithContext(ctx context.Context) GetHttpReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetHttpReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetHttpReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetHttpReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetHttpReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetHttpReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetHttpReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetHttpReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetHttpReferenceResultOutput{})
+}
@@ -0,0 +1,208 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in a Kubernetes secret.
+func GetKubernetesReference(ctx *pulumi.Context, args *GetKubernetesReferenceArgs, opts ...pulumi.InvokeOption) (*GetKubernetesReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetKubernetesReferenceResult
+	err := ctx.Invoke("terraform:state:getKubernetesReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetKubernetesReferenceArgs struct {
+	// The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+	ClientCertificate *string `pulumi:"clientCertificate"`
+	// The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+	ClientKey *string `pulumi:"clientKey"`
+	// The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+	ClusterCaCertificate *string `pulumi:"clusterCaCertificate"`
+	// The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+	ConfigContext *string `pulumi:"configContext"`
+	// The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+	ConfigContextAuthInfo *string `pulumi:"configContextAuthInfo"`
+	// The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+	ConfigContextCluster *string `pulumi:"configContextCluster"`
+	// The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+	ConfigPath *string `pulumi:"configPath"`
+	// A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+	ConfigPaths []string `pulumi:"configPaths"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// A credential plugin used to obtain credentials for the API server.
+	Exec *KubernetesExec `pulumi:"exec"`
+	// The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+	Host *string `pulumi:"host"`
+	// Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+	InClusterConfig *bool `pulumi:"inClusterConfig"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+	Insecure *bool `pulumi:"insecure"`
+	// The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+	Namespace *string `pulumi:"namespace"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+	SecretSuffix string `pulumi:"secretSuffix"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+	Token *string `pulumi:"token"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetKubernetesReferenceArgs
+func (val *GetKubernetesReferenceArgs) Defaults() *GetKubernetesReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetKubernetesReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetKubernetesReferenceOutput(ctx *pulumi.Context, args GetKubernetesReferenceOutputArgs, opts ...pulumi.InvokeOption) GetKubernetesReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetKubernetesReferenceResultOutput, error) {
+			args := v.(GetKubernetesReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getKubernetesReference", args.Defaults(), GetKubernetesReferenceResultOutput{}, options).(GetKubernetesReferenceResultOutput), nil
+		}).(GetKubernetesReferenceResultOutput)
+}
+
+type GetKubernetesReferenceOutputArgs struct {
+	// The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+	ClientCertificate pulumi.StringPtrInput `pulumi:"clientCertificate"`
+	// The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+	ClientKey pulumi.StringPtrInput `pulumi:"clientKey"`
+	// The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+	ClusterCaCertificate pulumi.StringPtrInput `pulumi:"clusterCaCertificate"`
+	// The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+	ConfigContext pulumi.StringPtrInput `pulumi:"configContext"`
+	// The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+	ConfigContextAuthInfo pulumi.StringPtrInput `pulumi:"configContextAuthInfo"`
+	// The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+	ConfigContextCluster pulumi.StringPtrInput `pulumi:"configContextCluster"`
+	// The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+	ConfigPath pulumi.StringPtrInput `pulumi:"configPath"`
+	// A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+	ConfigPaths pulumi.StringArrayInput `pulumi:"configPaths"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// A credential plugin used to obtain credentials for the API server.
+	Exec KubernetesExecPtrInput `pulumi:"exec"`
+	// The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+	Host pulumi.StringPtrInput `pulumi:"host"`
+	// Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+	InClusterConfig pulumi.BoolPtrInput `pulumi:"inClusterConfig"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+	Insecure pulumi.BoolPtrInput `pulumi:"insecure"`
+	// The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+	Namespace pulumi.StringPtrInput `pulumi:"namespace"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+	SecretSuffix pulumi.StringInput `pulumi:"secretSuffix"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+	Token pulumi.StringPtrInput `pulumi:"token"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetKubernetesReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetKubernetesReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetKubernetesReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetKubernetesReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetKubernetesReferenceResult)(nil)).Elem()
+}
+
+func (o GetKubernetesReferenceResultOutput) ToGetKubernetesReferenceResultOutput() GetKubernetesReferenceResultOutput {
+	return o
+}
+
+func (o GetKubernetesReferenceResultOutput) ToGetKubernetesReferenceResultOutputWithContext(ctx context.Context) GetKubernetesReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetKubernetesReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetKubernetesReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetKubernetesReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetKubernetesReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetKubernetesReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetKubernetesReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetKubernetesReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetKubernetesReferenceResultOutput{})
+}
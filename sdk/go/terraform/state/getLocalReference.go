@@ -23,16 +23,38 @@ func GetLocalReference(ctx *pulumi.Context, args *GetLocalReferenceArgs, opts ..
 }
 
 type GetLocalReferenceArgs struct {
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
 	// The path to the tfstate file. This defaults to "terraform.tfstate" relative to the root module by default.
 	Path *string `pulumi:"path"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
 	// The path to non-default workspaces.
 	WorkspaceDir *string `pulumi:"workspaceDir"`
 }
 
 // The result of fetching from a Terraform state store.
 type GetLocalReferenceResult struct {
-	// The outputs displayed from Terraform state.
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
 }
 
 func GetLocalReferenceOutput(ctx *pulumi.Context, args GetLocalReferenceOutputArgs, opts ...pulumi.InvokeOption) GetLocalReferenceResultOutput {
@@ -45,8 +67,20 @@ func GetLocalReferenceOutput(ctx *pulumi.Context, args GetLocalReferenceOutputAr
 }
 
 type GetLocalReferenceOutputArgs struct {
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
 	// The path to the tfstate file. This defaults to "terraform.tfstate" relative to the root module by default.
 	Path pulumi.StringPtrInput `pulumi:"path"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
 	// The path to non-default workspaces.
 	WorkspaceDir pulumi.StringPtrInput `pulumi:"workspaceDir"`
 }
@@ -70,11 +104,36 @@ func (o GetLocalReferenceResultOutput) ToGetLocalReferenceResultOutputWithContex
 	return o
 }
 
-// The outputs displayed from Terraform state.
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetLocalReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetLocalReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 func (o GetLocalReferenceResultOutput) Outputs() pulumi.MapOutput {
 	return o.ApplyT(func(v GetLocalReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
 }
 
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetLocalReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetLocalReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetLocalReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetLocalReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetLocalReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetLocalReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetLocalReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetLocalReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterOutputType(GetLocalReferenceResultOutput{})
 }
@@ -0,0 +1,212 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.
+func GetOssReference(ctx *pulumi.Context, args *GetOssReferenceArgs, opts ...pulumi.InvokeOption) (*GetOssReferenceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetOssReferenceResult
+	err := ctx.Invoke("terraform:state:getOssReference", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetOssReferenceArgs struct {
+	// Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+	AccessKey *string `pulumi:"accessKey"`
+	// A policy further restricting the permissions of the assumed role.
+	AssumeRolePolicy *string `pulumi:"assumeRolePolicy"`
+	// The duration, in seconds, of the assume role session, between 900 and 3600.
+	AssumeRoleSessionExpiration *int `pulumi:"assumeRoleSessionExpiration"`
+	// The name of the OSS bucket.
+	Bucket string `pulumi:"bucket"`
+	// The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+	EcsRoleName *string `pulumi:"ecsRoleName"`
+	// A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+	Endpoint *string `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+	Key *string `pulumi:"key"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The directory in the bucket holding the state. Defaults to env:.
+	Prefix *string `pulumi:"prefix"`
+	// The profile name as set in the shared credentials file.
+	Profile *string `pulumi:"profile"`
+	// The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+	Region *string `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// The ARN of a RAM role to be assumed in order to read the state.
+	RoleArn *string `pulumi:"roleArn"`
+	// Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+	SecretKey *string `pulumi:"secretKey"`
+	// The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+	SecurityToken *string `pulumi:"securityToken"`
+	// The session name to use when assuming the role.
+	SessionName *string `pulumi:"sessionName"`
+	// Path to a shared credentials file.
+	SharedCredentialsFile *string `pulumi:"sharedCredentialsFile"`
+	// A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+	StsEndpoint *string `pulumi:"stsEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace *string `pulumi:"workspace"`
+}
+
+// Defaults sets the appropriate defaults for GetOssReferenceArgs
+func (val *GetOssReferenceArgs) Defaults() *GetOssReferenceArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Workspace == nil {
+		workspace_ := "default"
+		tmp.Workspace = &workspace_
+	}
+	return &tmp
+}
+
+// The result of fetching from a Terraform state store.
+type GetOssReferenceResult struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+func GetOssReferenceOutput(ctx *pulumi.Context, args GetOssReferenceOutputArgs, opts ...pulumi.InvokeOption) GetOssReferenceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetOssReferenceResultOutput, error) {
+			args := v.(GetOssReferenceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getOssReference", args.Defaults(), GetOssReferenceResultOutput{}, options).(GetOssReferenceResultOutput), nil
+		}).(GetOssReferenceResultOutput)
+}
+
+type GetOssReferenceOutputArgs struct {
+	// Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+	AccessKey pulumi.StringPtrInput `pulumi:"accessKey"`
+	// A policy further restricting the permissions of the assumed role.
+	AssumeRolePolicy pulumi.StringPtrInput `pulumi:"assumeRolePolicy"`
+	// The duration, in seconds, of the assume role session, between 900 and 3600.
+	AssumeRoleSessionExpiration pulumi.IntPtrInput `pulumi:"assumeRoleSessionExpiration"`
+	// The name of the OSS bucket.
+	Bucket pulumi.StringInput `pulumi:"bucket"`
+	// The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+	EcsRoleName pulumi.StringPtrInput `pulumi:"ecsRoleName"`
+	// A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+	Endpoint pulumi.StringPtrInput `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+	Key pulumi.StringPtrInput `pulumi:"key"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The directory in the bucket holding the state. Defaults to env:.
+	Prefix pulumi.StringPtrInput `pulumi:"prefix"`
+	// The profile name as set in the shared credentials file.
+	Profile pulumi.StringPtrInput `pulumi:"profile"`
+	// The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// The ARN of a RAM role to be assumed in order to read the state.
+	RoleArn pulumi.StringPtrInput `pulumi:"roleArn"`
+	// Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+	SecretKey pulumi.StringPtrInput `pulumi:"secretKey"`
+	// The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+	SecurityToken pulumi.StringPtrInput `pulumi:"securityToken"`
+	// The session name to use when assuming the role.
+	SessionName pulumi.StringPtrInput `pulumi:"sessionName"`
+	// Path to a shared credentials file.
+	SharedCredentialsFile pulumi.StringPtrInput `pulumi:"sharedCredentialsFile"`
+	// A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+	StsEndpoint pulumi.StringPtrInput `pulumi:"stsEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The Terraform workspace to read state from.
+	Workspace pulumi.StringPtrInput `pulumi:"workspace"`
+}
+
+func (GetOssReferenceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetOssReferenceArgs)(nil)).Elem()
+}
+
+// The result of fetching from a Terraform state store.
+type GetOssReferenceResultOutput struct{ *pulumi.OutputState }
+
+func (GetOssReferenceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetOssReferenceResult)(nil)).Elem()
+}
+
+func (o GetOssReferenceResultOutput) ToGetOssReferenceResultOutput() GetOssReferenceResultOutput {
+	return o
+}
+
+func (o GetOssReferenceResultOutput) ToGetOssReferenceResultOutputWithContext(ctx context.Context) GetOssReferenceResultOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetOssReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o GetOssReferenceResultOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetOssReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetOssReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetOssReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetOssReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetOssReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetOssReferenceResultOutput{})
+}
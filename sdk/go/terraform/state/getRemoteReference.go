@@ -15,7 +15,7 @@ import (
 func GetRemoteReference(ctx *pulumi.Context, args *GetRemoteReferenceArgs, opts ...pulumi.InvokeOption) (*GetRemoteReferenceResult, error) {
 	opts = internal.PkgInvokeDefaultOpts(opts)
 	var rv GetRemoteReferenceResult
-	err := ctx.Invoke("terraform:state:getRemoteReference", args.Defaults(), &rv, opts...)
+	err := ctx.Invoke("terraform:state:getRemoteReference", args, &rv, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -23,32 +23,41 @@ func GetRemoteReference(ctx *pulumi.Context, args *GetRemoteReferenceArgs, opts
 }
 
 type GetRemoteReferenceArgs struct {
-	// The remote backend hostname to connect to.
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// The remote backend hostname to connect to. Defaults to app.terraform.io.
 	Hostname *string `pulumi:"hostname"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
 	// The name of the organization containing the targeted workspace(s).
 	Organization string `pulumi:"organization"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
 	// The token used to authenticate with the remote backend.
 	Token      *string    `pulumi:"token"`
 	Workspaces Workspaces `pulumi:"workspaces"`
 }
 
-// Defaults sets the appropriate defaults for GetRemoteReferenceArgs
-func (val *GetRemoteReferenceArgs) Defaults() *GetRemoteReferenceArgs {
-	if val == nil {
-		return nil
-	}
-	tmp := *val
-	if tmp.Hostname == nil {
-		hostname_ := "app.terraform.io"
-		tmp.Hostname = &hostname_
-	}
-	return &tmp
-}
-
 // The result of fetching from a Terraform state store.
 type GetRemoteReferenceResult struct {
-	// The outputs displayed from Terraform state.
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
 }
 
 func GetRemoteReferenceOutput(ctx *pulumi.Context, args GetRemoteReferenceOutputArgs, opts ...pulumi.InvokeOption) GetRemoteReferenceResultOutput {
@@ -56,15 +65,27 @@ func GetRemoteReferenceOutput(ctx *pulumi.Context, args GetRemoteReferenceOutput
 		ApplyT(func(v interface{}) (GetRemoteReferenceResultOutput, error) {
 			args := v.(GetRemoteReferenceArgs)
 			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
-			return ctx.InvokeOutput("terraform:state:getRemoteReference", args.Defaults(), GetRemoteReferenceResultOutput{}, options).(GetRemoteReferenceResultOutput), nil
+			return ctx.InvokeOutput("terraform:state:getRemoteReference", args, GetRemoteReferenceResultOutput{}, options).(GetRemoteReferenceResultOutput), nil
 		}).(GetRemoteReferenceResultOutput)
 }
 
 type GetRemoteReferenceOutputArgs struct {
-	// The remote backend hostname to connect to.
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// The remote backend hostname to connect to. Defaults to app.terraform.io.
 	Hostname pulumi.StringPtrInput `pulumi:"hostname"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
 	// The name of the organization containing the targeted workspace(s).
 	Organization pulumi.StringInput `pulumi:"organization"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
 	// The token used to authenticate with the remote backend.
 	Token      pulumi.StringPtrInput `pulumi:"token"`
 	Workspaces WorkspacesInput       `pulumi:"workspaces"`
@@ -89,11 +110,36 @@ func (o GetRemoteReferenceResultOutput) ToGetRemoteReferenceResultOutputWithCont
 	return o
 }
 
-// The outputs displayed from Terraform state.
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetRemoteReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetRemoteReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 func (o GetRemoteReferenceResultOutput) Outputs() pulumi.MapOutput {
 	return o.ApplyT(func(v GetRemoteReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
 }
 
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetRemoteReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetRemoteReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetRemoteReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetRemoteReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetRemoteReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetRemoteReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetRemoteReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetRemoteReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterOutputType(GetRemoteReferenceResultOutput{})
 }
@@ -41,22 +41,32 @@ type GetS3ReferenceArgs struct {
 	Encrypt *bool `pulumi:"encrypt"`
 	// A custom endpoint for the S3 API.
 	Endpoint *string `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
 	// The external ID to use when assuming the role.
 	ExternalId *string `pulumi:"externalId"`
 	// Force s3 to use path-style addressing instead of virtual hosted-bucket addressing. Required by most S3-compatible stores.
 	ForcePathStyle *bool `pulumi:"forcePathStyle"`
 	// A custom endpoint for the IAM API.
 	IamEndpoint *string `pulumi:"iamEndpoint"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
 	// The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
 	Key string `pulumi:"key"`
 	// The ARN of a KMS Key to use for encrypting the state.
 	KmsKeyId *string `pulumi:"kmsKeyId"`
 	// The maximum number of times an AWS API request is retried on retryable failure.
 	MaxRetries *int `pulumi:"maxRetries"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
 	// AWS profile name as set in the shared credentials file.
 	Profile *string `pulumi:"profile"`
 	// AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
 	Region *string `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
 	// The ARN of an IAM Role to be assumed in order to read the state.
 	RoleArn *string `pulumi:"roleArn"`
 	// AWS secret key.
@@ -75,6 +85,8 @@ type GetS3ReferenceArgs struct {
 	SseCustomerKey *string `pulumi:"sseCustomerKey"`
 	// A custom endpoint for the STS API.
 	StsEndpoint *string `pulumi:"stsEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
 	// AWS session token.
 	Token *string `pulumi:"token"`
 	// The Terraform workspace to read state from.
@@ -98,8 +110,18 @@ func (val *GetS3ReferenceArgs) Defaults() *GetS3ReferenceArgs {
 
 // The result of fetching from a Terraform state store.
 type GetS3ReferenceResult struct {
-	// The outputs displayed from Terraform state.
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
 }
 
 func GetS3ReferenceOutput(ctx *pulumi.Context, args GetS3ReferenceOutputArgs, opts ...pulumi.InvokeOption) GetS3ReferenceResultOutput {
@@ -130,22 +152,32 @@ type GetS3ReferenceOutputArgs struct {
 	Encrypt pulumi.BoolPtrInput `pulumi:"encrypt"`
 	// A custom endpoint for the S3 API.
 	Endpoint pulumi.StringPtrInput `pulumi:"endpoint"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
 	// The external ID to use when assuming the role.
 	ExternalId pulumi.StringPtrInput `pulumi:"externalId"`
 	// Force s3 to use path-style addressing instead of virtual hosted-bucket addressing. Required by most S3-compatible stores.
 	ForcePathStyle pulumi.BoolPtrInput `pulumi:"forcePathStyle"`
 	// A custom endpoint for the IAM API.
 	IamEndpoint pulumi.StringPtrInput `pulumi:"iamEndpoint"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
 	// The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
 	Key pulumi.StringInput `pulumi:"key"`
 	// The ARN of a KMS Key to use for encrypting the state.
 	KmsKeyId pulumi.StringPtrInput `pulumi:"kmsKeyId"`
 	// The maximum number of times an AWS API request is retried on retryable failure.
 	MaxRetries pulumi.IntPtrInput `pulumi:"maxRetries"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
 	// AWS profile name as set in the shared credentials file.
 	Profile pulumi.StringPtrInput `pulumi:"profile"`
 	// AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
 	Region pulumi.StringPtrInput `pulumi:"region"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
 	// The ARN of an IAM Role to be assumed in order to read the state.
 	RoleArn pulumi.StringPtrInput `pulumi:"roleArn"`
 	// AWS secret key.
@@ -164,6 +196,8 @@ type GetS3ReferenceOutputArgs struct {
 	SseCustomerKey pulumi.StringPtrInput `pulumi:"sseCustomerKey"`
 	// A custom endpoint for the STS API.
 	StsEndpoint pulumi.StringPtrInput `pulumi:"stsEndpoint"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
 	// AWS session token.
 	Token pulumi.StringPtrInput `pulumi:"token"`
 	// The Terraform workspace to read state from.
@@ -191,11 +225,36 @@ func (o GetS3ReferenceResultOutput) ToGetS3ReferenceResultOutputWithContext(ctx
 	return o
 }
 
-// The outputs displayed from Terraform state.
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o GetS3ReferenceResultOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v GetS3ReferenceResult) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
 func (o GetS3ReferenceResultOutput) Outputs() pulumi.MapOutput {
 	return o.ApplyT(func(v GetS3ReferenceResult) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
 }
 
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o GetS3ReferenceResultOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v GetS3ReferenceResult) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o GetS3ReferenceResultOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v GetS3ReferenceResult) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o GetS3ReferenceResultOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v GetS3ReferenceResult) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o GetS3ReferenceResultOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GetS3ReferenceResult) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterOutputType(GetS3ReferenceResultOutput{})
 }
@@ -0,0 +1,142 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package state
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-terraform/sdk/v6/go/terraform/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Access the state of several workspaces of any backend Terraform supports, configured as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.
+func GetWorkspaceReferences(ctx *pulumi.Context, args *GetWorkspaceReferencesArgs, opts ...pulumi.InvokeOption) (*GetWorkspaceReferencesResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetWorkspaceReferencesResult
+	err := ctx.Invoke("terraform:state:getWorkspaceReferences", args.Defaults(), &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetWorkspaceReferencesArgs struct {
+	// The type of the backend, as in the label of the backend block, e.g. s3.
+	BackendType string `pulumi:"backendType"`
+	// The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+	Config map[string]interface{} `pulumi:"config"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env map[string]string `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources *bool `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs []string `pulumi:"outputs"`
+	// The most workspaces read at the same time.
+	Parallelism *int `pulumi:"parallelism"`
+	// Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+	Prefix *string `pulumi:"prefix"`
+	// Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+	Regex *string `pulumi:"regex"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs []string `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources []string `pulumi:"resources"`
+	// Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+	SecretConfig map[string]interface{} `pulumi:"secretConfig"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout *string `pulumi:"timeout"`
+	// The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+	Workspaces []string `pulumi:"workspaces"`
+}
+
+// Defaults sets the appropriate defaults for GetWorkspaceReferencesArgs
+func (val *GetWorkspaceReferencesArgs) Defaults() *GetWorkspaceReferencesArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Parallelism == nil {
+		parallelism_ := 4
+		tmp.Parallelism = &parallelism_
+	}
+	return &tmp
+}
+
+type GetWorkspaceReferencesResult struct {
+	// The error reading each workspace that could not be read, keyed by workspace name.
+	Errors map[string]string `pulumi:"errors"`
+	// The state of each workspace read successfully, keyed by workspace name.
+	Workspaces map[string]StateReferenceOutputs `pulumi:"workspaces"`
+}
+
+func GetWorkspaceReferencesOutput(ctx *pulumi.Context, args GetWorkspaceReferencesOutputArgs, opts ...pulumi.InvokeOption) GetWorkspaceReferencesResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetWorkspaceReferencesResultOutput, error) {
+			args := v.(GetWorkspaceReferencesArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("terraform:state:getWorkspaceReferences", args.Defaults(), GetWorkspaceReferencesResultOutput{}, options).(GetWorkspaceReferencesResultOutput), nil
+		}).(GetWorkspaceReferencesResultOutput)
+}
+
+type GetWorkspaceReferencesOutputArgs struct {
+	// The type of the backend, as in the label of the backend block, e.g. s3.
+	BackendType pulumi.StringInput `pulumi:"backendType"`
+	// The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+	Config pulumi.MapInput `pulumi:"config"`
+	// Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+	IncludeResources pulumi.BoolPtrInput `pulumi:"includeResources"`
+	// The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+	Outputs pulumi.StringArrayInput `pulumi:"outputs"`
+	// The most workspaces read at the same time.
+	Parallelism pulumi.IntPtrInput `pulumi:"parallelism"`
+	// Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+	Prefix pulumi.StringPtrInput `pulumi:"prefix"`
+	// Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+	Regex pulumi.StringPtrInput `pulumi:"regex"`
+	// The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+	RequiredOutputs pulumi.StringArrayInput `pulumi:"requiredOutputs"`
+	// The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+	Resources pulumi.StringArrayInput `pulumi:"resources"`
+	// Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+	SecretConfig pulumi.MapInput `pulumi:"secretConfig"`
+	// How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+	// The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+	Workspaces pulumi.StringArrayInput `pulumi:"workspaces"`
+}
+
+func (GetWorkspaceReferencesOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetWorkspaceReferencesArgs)(nil)).Elem()
+}
+
+type GetWorkspaceReferencesResultOutput struct{ *pulumi.OutputState }
+
+func (GetWorkspaceReferencesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetWorkspaceReferencesResult)(nil)).Elem()
+}
+
+func (o GetWorkspaceReferencesResultOutput) ToGetWorkspaceReferencesResultOutput() GetWorkspaceReferencesResultOutput {
+	return o
+}
+
+func (o GetWorkspaceReferencesResultOutput) ToGetWorkspaceReferencesResultOutputWithContext(ctx context.Context) GetWorkspaceReferencesResultOutput {
+	return o
+}
+
+// The error reading each workspace that could not be read, keyed by workspace name.
+func (o GetWorkspaceReferencesResultOutput) Errors() pulumi.StringMapOutput {
+	return o.ApplyT(func(v GetWorkspaceReferencesResult) map[string]string { return v.Errors }).(pulumi.StringMapOutput)
+}
+
+// The state of each workspace read successfully, keyed by workspace name.
+func (o GetWorkspaceReferencesResultOutput) Workspaces() StateReferenceOutputsMapOutput {
+	return o.ApplyT(func(v GetWorkspaceReferencesResult) map[string]StateReferenceOutputs { return v.Workspaces }).(StateReferenceOutputsMapOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetWorkspaceReferencesResultOutput{})
+}
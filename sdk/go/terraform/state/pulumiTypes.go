@@ -13,6 +13,1899 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type AzureRMDefaults struct {
+	// The password of the default client certificate.
+	ClientCertificatePassword *string `pulumi:"clientCertificatePassword"`
+	// The default path to the PFX file used as the client certificate.
+	ClientCertificatePath *string `pulumi:"clientCertificatePath"`
+	// The default client ID to authenticate as.
+	ClientId *string `pulumi:"clientId"`
+	// The default client secret used for service principal authentication.
+	ClientSecret *string `pulumi:"clientSecret"`
+	// The default custom endpoint for the Azure Resource Manager API.
+	Endpoint *string `pulumi:"endpoint"`
+	// The default Azure cloud environment.
+	Environment *string `pulumi:"environment"`
+	// The default hostname of the Azure metadata service.
+	MetadataHost *string `pulumi:"metadataHost"`
+	// The default endpoint of the Managed Service Identity.
+	MsiEndpoint *string `pulumi:"msiEndpoint"`
+	// The default bearer token for requests to oidcRequestUrl.
+	OidcRequestToken *string `pulumi:"oidcRequestToken"`
+	// The default URL of the OIDC provider to request an ID token from.
+	OidcRequestUrl *string `pulumi:"oidcRequestUrl"`
+	// The default JWT token for OIDC authentication.
+	OidcToken *string `pulumi:"oidcToken"`
+	// The default path to a file containing a JWT token for OIDC authentication.
+	OidcTokenFilePath *string `pulumi:"oidcTokenFilePath"`
+	// The default subscription ID holding the storage account.
+	SubscriptionId *string `pulumi:"subscriptionId"`
+	// The default tenant ID to authenticate against.
+	TenantId *string `pulumi:"tenantId"`
+	// Whether to authenticate against the storage container with AzureAD by default.
+	UseAzureadAuth *bool `pulumi:"useAzureadAuth"`
+	// Whether to authenticate using Managed Service Identity by default.
+	UseMsi *bool `pulumi:"useMsi"`
+	// Whether to authenticate using OIDC by default.
+	UseOidc *bool `pulumi:"useOidc"`
+}
+
+// AzureRMDefaultsInput is an input type that accepts AzureRMDefaultsArgs and AzureRMDefaultsOutput values.
+// You can construct a concrete instance of `AzureRMDefaultsInput` via:
+//
+//	AzureRMDefaultsArgs{...}
+type AzureRMDefaultsInput interface {
+	pulumi.Input
+
+	ToAzureRMDefaultsOutput() AzureRMDefaultsOutput
+	ToAzureRMDefaultsOutputWithContext(context.Context) AzureRMDefaultsOutput
+}
+
+type AzureRMDefaultsArgs struct {
+	// The password of the default client certificate.
+	ClientCertificatePassword pulumi.StringPtrInput `pulumi:"clientCertificatePassword"`
+	// The default path to the PFX file used as the client certificate.
+	ClientCertificatePath pulumi.StringPtrInput `pulumi:"clientCertificatePath"`
+	// The default client ID to authenticate as.
+	ClientId pulumi.StringPtrInput `pulumi:"clientId"`
+	// The default client secret used for service principal authentication.
+	ClientSecret pulumi.StringPtrInput `pulumi:"clientSecret"`
+	// The default custom endpoint for the Azure Resource Manager API.
+	Endpoint pulumi.StringPtrInput `pulumi:"endpoint"`
+	// The default Azure cloud environment.
+	Environment pulumi.StringPtrInput `pulumi:"environment"`
+	// The default hostname of the Azure metadata service.
+	MetadataHost pulumi.StringPtrInput `pulumi:"metadataHost"`
+	// The default endpoint of the Managed Service Identity.
+	MsiEndpoint pulumi.StringPtrInput `pulumi:"msiEndpoint"`
+	// The default bearer token for requests to oidcRequestUrl.
+	OidcRequestToken pulumi.StringPtrInput `pulumi:"oidcRequestToken"`
+	// The default URL of the OIDC provider to request an ID token from.
+	OidcRequestUrl pulumi.StringPtrInput `pulumi:"oidcRequestUrl"`
+	// The default JWT token for OIDC authentication.
+	OidcToken pulumi.StringPtrInput `pulumi:"oidcToken"`
+	// The default path to a file containing a JWT token for OIDC authentication.
+	OidcTokenFilePath pulumi.StringPtrInput `pulumi:"oidcTokenFilePath"`
+	// The default subscription ID holding the storage account.
+	SubscriptionId pulumi.StringPtrInput `pulumi:"subscriptionId"`
+	// The default tenant ID to authenticate against.
+	TenantId pulumi.StringPtrInput `pulumi:"tenantId"`
+	// Whether to authenticate against the storage container with AzureAD by default.
+	UseAzureadAuth pulumi.BoolPtrInput `pulumi:"useAzureadAuth"`
+	// Whether to authenticate using Managed Service Identity by default.
+	UseMsi pulumi.BoolPtrInput `pulumi:"useMsi"`
+	// Whether to authenticate using OIDC by default.
+	UseOidc pulumi.BoolPtrInput `pulumi:"useOidc"`
+}
+
+func (AzureRMDefaultsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*AzureRMDefaults)(nil)).Elem()
+}
+
+func (i AzureRMDefaultsArgs) ToAzureRMDefaultsOutput() AzureRMDefaultsOutput {
+	return i.ToAzureRMDefaultsOutputWithContext(context.Background())
+}
+
+func (i AzureRMDefaultsArgs) ToAzureRMDefaultsOutputWithContext(ctx context.Context) AzureRMDefaultsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AzureRMDefaultsOutput)
+}
+
+func (i AzureRMDefaultsArgs) ToAzureRMDefaultsPtrOutput() AzureRMDefaultsPtrOutput {
+	return i.ToAzureRMDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i AzureRMDefaultsArgs) ToAzureRMDefaultsPtrOutputWithContext(ctx context.Context) AzureRMDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AzureRMDefaultsOutput).ToAzureRMDefaultsPtrOutputWithContext(ctx)
+}
+
+// AzureRMDefaultsPtrInput is an input type that accepts AzureRMDefaultsArgs, AzureRMDefaultsPtr and AzureRMDefaultsPtrOutput values.
+// You can construct a concrete instance of `AzureRMDefaultsPtrInput` via:
+//
+//	        AzureRMDefaultsArgs{...}
+//
+//	or:
+//
+//	        nil
+type AzureRMDefaultsPtrInput interface {
+	pulumi.Input
+
+	ToAzureRMDefaultsPtrOutput() AzureRMDefaultsPtrOutput
+	ToAzureRMDefaultsPtrOutputWithContext(context.Context) AzureRMDefaultsPtrOutput
+}
+
+type azureRMDefaultsPtrType AzureRMDefaultsArgs
+
+func AzureRMDefaultsPtr(v *AzureRMDefaultsArgs) AzureRMDefaultsPtrInput {
+	return (*azureRMDefaultsPtrType)(v)
+}
+
+func (*azureRMDefaultsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**AzureRMDefaults)(nil)).Elem()
+}
+
+func (i *azureRMDefaultsPtrType) ToAzureRMDefaultsPtrOutput() AzureRMDefaultsPtrOutput {
+	return i.ToAzureRMDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i *azureRMDefaultsPtrType) ToAzureRMDefaultsPtrOutputWithContext(ctx context.Context) AzureRMDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AzureRMDefaultsPtrOutput)
+}
+
+type AzureRMDefaultsOutput struct{ *pulumi.OutputState }
+
+func (AzureRMDefaultsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AzureRMDefaults)(nil)).Elem()
+}
+
+func (o AzureRMDefaultsOutput) ToAzureRMDefaultsOutput() AzureRMDefaultsOutput {
+	return o
+}
+
+func (o AzureRMDefaultsOutput) ToAzureRMDefaultsOutputWithContext(ctx context.Context) AzureRMDefaultsOutput {
+	return o
+}
+
+func (o AzureRMDefaultsOutput) ToAzureRMDefaultsPtrOutput() AzureRMDefaultsPtrOutput {
+	return o.ToAzureRMDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (o AzureRMDefaultsOutput) ToAzureRMDefaultsPtrOutputWithContext(ctx context.Context) AzureRMDefaultsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v AzureRMDefaults) *AzureRMDefaults {
+		return &v
+	}).(AzureRMDefaultsPtrOutput)
+}
+
+// The password of the default client certificate.
+func (o AzureRMDefaultsOutput) ClientCertificatePassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.ClientCertificatePassword }).(pulumi.StringPtrOutput)
+}
+
+// The default path to the PFX file used as the client certificate.
+func (o AzureRMDefaultsOutput) ClientCertificatePath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.ClientCertificatePath }).(pulumi.StringPtrOutput)
+}
+
+// The default client ID to authenticate as.
+func (o AzureRMDefaultsOutput) ClientId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.ClientId }).(pulumi.StringPtrOutput)
+}
+
+// The default client secret used for service principal authentication.
+func (o AzureRMDefaultsOutput) ClientSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.ClientSecret }).(pulumi.StringPtrOutput)
+}
+
+// The default custom endpoint for the Azure Resource Manager API.
+func (o AzureRMDefaultsOutput) Endpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.Endpoint }).(pulumi.StringPtrOutput)
+}
+
+// The default Azure cloud environment.
+func (o AzureRMDefaultsOutput) Environment() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.Environment }).(pulumi.StringPtrOutput)
+}
+
+// The default hostname of the Azure metadata service.
+func (o AzureRMDefaultsOutput) MetadataHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.MetadataHost }).(pulumi.StringPtrOutput)
+}
+
+// The default endpoint of the Managed Service Identity.
+func (o AzureRMDefaultsOutput) MsiEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.MsiEndpoint }).(pulumi.StringPtrOutput)
+}
+
+// The default bearer token for requests to oidcRequestUrl.
+func (o AzureRMDefaultsOutput) OidcRequestToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.OidcRequestToken }).(pulumi.StringPtrOutput)
+}
+
+// The default URL of the OIDC provider to request an ID token from.
+func (o AzureRMDefaultsOutput) OidcRequestUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.OidcRequestUrl }).(pulumi.StringPtrOutput)
+}
+
+// The default JWT token for OIDC authentication.
+func (o AzureRMDefaultsOutput) OidcToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.OidcToken }).(pulumi.StringPtrOutput)
+}
+
+// The default path to a file containing a JWT token for OIDC authentication.
+func (o AzureRMDefaultsOutput) OidcTokenFilePath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.OidcTokenFilePath }).(pulumi.StringPtrOutput)
+}
+
+// The default subscription ID holding the storage account.
+func (o AzureRMDefaultsOutput) SubscriptionId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.SubscriptionId }).(pulumi.StringPtrOutput)
+}
+
+// The default tenant ID to authenticate against.
+func (o AzureRMDefaultsOutput) TenantId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *string { return v.TenantId }).(pulumi.StringPtrOutput)
+}
+
+// Whether to authenticate against the storage container with AzureAD by default.
+func (o AzureRMDefaultsOutput) UseAzureadAuth() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *bool { return v.UseAzureadAuth }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to authenticate using Managed Service Identity by default.
+func (o AzureRMDefaultsOutput) UseMsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *bool { return v.UseMsi }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to authenticate using OIDC by default.
+func (o AzureRMDefaultsOutput) UseOidc() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v AzureRMDefaults) *bool { return v.UseOidc }).(pulumi.BoolPtrOutput)
+}
+
+type AzureRMDefaultsPtrOutput struct{ *pulumi.OutputState }
+
+func (AzureRMDefaultsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**AzureRMDefaults)(nil)).Elem()
+}
+
+func (o AzureRMDefaultsPtrOutput) ToAzureRMDefaultsPtrOutput() AzureRMDefaultsPtrOutput {
+	return o
+}
+
+func (o AzureRMDefaultsPtrOutput) ToAzureRMDefaultsPtrOutputWithContext(ctx context.Context) AzureRMDefaultsPtrOutput {
+	return o
+}
+
+func (o AzureRMDefaultsPtrOutput) Elem() AzureRMDefaultsOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) AzureRMDefaults {
+		if v != nil {
+			return *v
+		}
+		var ret AzureRMDefaults
+		return ret
+	}).(AzureRMDefaultsOutput)
+}
+
+// The password of the default client certificate.
+func (o AzureRMDefaultsPtrOutput) ClientCertificatePassword() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClientCertificatePassword
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default path to the PFX file used as the client certificate.
+func (o AzureRMDefaultsPtrOutput) ClientCertificatePath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClientCertificatePath
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default client ID to authenticate as.
+func (o AzureRMDefaultsPtrOutput) ClientId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClientId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default client secret used for service principal authentication.
+func (o AzureRMDefaultsPtrOutput) ClientSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClientSecret
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default custom endpoint for the Azure Resource Manager API.
+func (o AzureRMDefaultsPtrOutput) Endpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Endpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default Azure cloud environment.
+func (o AzureRMDefaultsPtrOutput) Environment() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Environment
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default hostname of the Azure metadata service.
+func (o AzureRMDefaultsPtrOutput) MetadataHost() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MetadataHost
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default endpoint of the Managed Service Identity.
+func (o AzureRMDefaultsPtrOutput) MsiEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.MsiEndpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default bearer token for requests to oidcRequestUrl.
+func (o AzureRMDefaultsPtrOutput) OidcRequestToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OidcRequestToken
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default URL of the OIDC provider to request an ID token from.
+func (o AzureRMDefaultsPtrOutput) OidcRequestUrl() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OidcRequestUrl
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default JWT token for OIDC authentication.
+func (o AzureRMDefaultsPtrOutput) OidcToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OidcToken
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default path to a file containing a JWT token for OIDC authentication.
+func (o AzureRMDefaultsPtrOutput) OidcTokenFilePath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.OidcTokenFilePath
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default subscription ID holding the storage account.
+func (o AzureRMDefaultsPtrOutput) SubscriptionId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SubscriptionId
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default tenant ID to authenticate against.
+func (o AzureRMDefaultsPtrOutput) TenantId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TenantId
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to authenticate against the storage container with AzureAD by default.
+func (o AzureRMDefaultsPtrOutput) UseAzureadAuth() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.UseAzureadAuth
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to authenticate using Managed Service Identity by default.
+func (o AzureRMDefaultsPtrOutput) UseMsi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.UseMsi
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to authenticate using OIDC by default.
+func (o AzureRMDefaultsPtrOutput) UseOidc() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *AzureRMDefaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.UseOidc
+	}).(pulumi.BoolPtrOutput)
+}
+
+type CloudWorkspaces struct {
+	// The name of a single workspace. This option conflicts with tags.
+	Name *string `pulumi:"name"`
+	// The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+	Tags []string `pulumi:"tags"`
+}
+
+// CloudWorkspacesInput is an input type that accepts CloudWorkspacesArgs and CloudWorkspacesOutput values.
+// You can construct a concrete instance of `CloudWorkspacesInput` via:
+//
+//	CloudWorkspacesArgs{...}
+type CloudWorkspacesInput interface {
+	pulumi.Input
+
+	ToCloudWorkspacesOutput() CloudWorkspacesOutput
+	ToCloudWorkspacesOutputWithContext(context.Context) CloudWorkspacesOutput
+}
+
+type CloudWorkspacesArgs struct {
+	// The name of a single workspace. This option conflicts with tags.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+	Tags pulumi.StringArrayInput `pulumi:"tags"`
+}
+
+func (CloudWorkspacesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CloudWorkspaces)(nil)).Elem()
+}
+
+func (i CloudWorkspacesArgs) ToCloudWorkspacesOutput() CloudWorkspacesOutput {
+	return i.ToCloudWorkspacesOutputWithContext(context.Background())
+}
+
+func (i CloudWorkspacesArgs) ToCloudWorkspacesOutputWithContext(ctx context.Context) CloudWorkspacesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CloudWorkspacesOutput)
+}
+
+type CloudWorkspacesOutput struct{ *pulumi.OutputState }
+
+func (CloudWorkspacesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CloudWorkspaces)(nil)).Elem()
+}
+
+func (o CloudWorkspacesOutput) ToCloudWorkspacesOutput() CloudWorkspacesOutput {
+	return o
+}
+
+func (o CloudWorkspacesOutput) ToCloudWorkspacesOutputWithContext(ctx context.Context) CloudWorkspacesOutput {
+	return o
+}
+
+// The name of a single workspace. This option conflicts with tags.
+func (o CloudWorkspacesOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CloudWorkspaces) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+func (o CloudWorkspacesOutput) Tags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v CloudWorkspaces) []string { return v.Tags }).(pulumi.StringArrayOutput)
+}
+
+type CosAssumeRole struct {
+	// A policy further restricting the permissions of the assumed role.
+	Policy *string `pulumi:"policy"`
+	// The ARN of the role to assume.
+	RoleArn string `pulumi:"roleArn"`
+	// The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+	SessionDuration *int `pulumi:"sessionDuration"`
+	// The session name to use when assuming the role.
+	SessionName string `pulumi:"sessionName"`
+}
+
+// CosAssumeRoleInput is an input type that accepts CosAssumeRoleArgs and CosAssumeRoleOutput values.
+// You can construct a concrete instance of `CosAssumeRoleInput` via:
+//
+//	CosAssumeRoleArgs{...}
+type CosAssumeRoleInput interface {
+	pulumi.Input
+
+	ToCosAssumeRoleOutput() CosAssumeRoleOutput
+	ToCosAssumeRoleOutputWithContext(context.Context) CosAssumeRoleOutput
+}
+
+type CosAssumeRoleArgs struct {
+	// A policy further restricting the permissions of the assumed role.
+	Policy pulumi.StringPtrInput `pulumi:"policy"`
+	// The ARN of the role to assume.
+	RoleArn pulumi.StringInput `pulumi:"roleArn"`
+	// The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+	SessionDuration pulumi.IntPtrInput `pulumi:"sessionDuration"`
+	// The session name to use when assuming the role.
+	SessionName pulumi.StringInput `pulumi:"sessionName"`
+}
+
+func (CosAssumeRoleArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CosAssumeRole)(nil)).Elem()
+}
+
+func (i CosAssumeRoleArgs) ToCosAssumeRoleOutput() CosAssumeRoleOutput {
+	return i.ToCosAssumeRoleOutputWithContext(context.Background())
+}
+
+func (i CosAssumeRoleArgs) ToCosAssumeRoleOutputWithContext(ctx context.Context) CosAssumeRoleOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CosAssumeRoleOutput)
+}
+
+func (i CosAssumeRoleArgs) ToCosAssumeRolePtrOutput() CosAssumeRolePtrOutput {
+	return i.ToCosAssumeRolePtrOutputWithContext(context.Background())
+}
+
+func (i CosAssumeRoleArgs) ToCosAssumeRolePtrOutputWithContext(ctx context.Context) CosAssumeRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CosAssumeRoleOutput).ToCosAssumeRolePtrOutputWithContext(ctx)
+}
+
+// CosAssumeRolePtrInput is an input type that accepts CosAssumeRoleArgs, CosAssumeRolePtr and CosAssumeRolePtrOutput values.
+// You can construct a concrete instance of `CosAssumeRolePtrInput` via:
+//
+//	        CosAssumeRoleArgs{...}
+//
+//	or:
+//
+//	        nil
+type CosAssumeRolePtrInput interface {
+	pulumi.Input
+
+	ToCosAssumeRolePtrOutput() CosAssumeRolePtrOutput
+	ToCosAssumeRolePtrOutputWithContext(context.Context) CosAssumeRolePtrOutput
+}
+
+type cosAssumeRolePtrType CosAssumeRoleArgs
+
+func CosAssumeRolePtr(v *CosAssumeRoleArgs) CosAssumeRolePtrInput {
+	return (*cosAssumeRolePtrType)(v)
+}
+
+func (*cosAssumeRolePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CosAssumeRole)(nil)).Elem()
+}
+
+func (i *cosAssumeRolePtrType) ToCosAssumeRolePtrOutput() CosAssumeRolePtrOutput {
+	return i.ToCosAssumeRolePtrOutputWithContext(context.Background())
+}
+
+func (i *cosAssumeRolePtrType) ToCosAssumeRolePtrOutputWithContext(ctx context.Context) CosAssumeRolePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CosAssumeRolePtrOutput)
+}
+
+type CosAssumeRoleOutput struct{ *pulumi.OutputState }
+
+func (CosAssumeRoleOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CosAssumeRole)(nil)).Elem()
+}
+
+func (o CosAssumeRoleOutput) ToCosAssumeRoleOutput() CosAssumeRoleOutput {
+	return o
+}
+
+func (o CosAssumeRoleOutput) ToCosAssumeRoleOutputWithContext(ctx context.Context) CosAssumeRoleOutput {
+	return o
+}
+
+func (o CosAssumeRoleOutput) ToCosAssumeRolePtrOutput() CosAssumeRolePtrOutput {
+	return o.ToCosAssumeRolePtrOutputWithContext(context.Background())
+}
+
+func (o CosAssumeRoleOutput) ToCosAssumeRolePtrOutputWithContext(ctx context.Context) CosAssumeRolePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CosAssumeRole) *CosAssumeRole {
+		return &v
+	}).(CosAssumeRolePtrOutput)
+}
+
+// A policy further restricting the permissions of the assumed role.
+func (o CosAssumeRoleOutput) Policy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CosAssumeRole) *string { return v.Policy }).(pulumi.StringPtrOutput)
+}
+
+// The ARN of the role to assume.
+func (o CosAssumeRoleOutput) RoleArn() pulumi.StringOutput {
+	return o.ApplyT(func(v CosAssumeRole) string { return v.RoleArn }).(pulumi.StringOutput)
+}
+
+// The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+func (o CosAssumeRoleOutput) SessionDuration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v CosAssumeRole) *int { return v.SessionDuration }).(pulumi.IntPtrOutput)
+}
+
+// The session name to use when assuming the role.
+func (o CosAssumeRoleOutput) SessionName() pulumi.StringOutput {
+	return o.ApplyT(func(v CosAssumeRole) string { return v.SessionName }).(pulumi.StringOutput)
+}
+
+type CosAssumeRolePtrOutput struct{ *pulumi.OutputState }
+
+func (CosAssumeRolePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CosAssumeRole)(nil)).Elem()
+}
+
+func (o CosAssumeRolePtrOutput) ToCosAssumeRolePtrOutput() CosAssumeRolePtrOutput {
+	return o
+}
+
+func (o CosAssumeRolePtrOutput) ToCosAssumeRolePtrOutputWithContext(ctx context.Context) CosAssumeRolePtrOutput {
+	return o
+}
+
+func (o CosAssumeRolePtrOutput) Elem() CosAssumeRoleOutput {
+	return o.ApplyT(func(v *CosAssumeRole) CosAssumeRole {
+		if v != nil {
+			return *v
+		}
+		var ret CosAssumeRole
+		return ret
+	}).(CosAssumeRoleOutput)
+}
+
+// A policy further restricting the permissions of the assumed role.
+func (o CosAssumeRolePtrOutput) Policy() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CosAssumeRole) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Policy
+	}).(pulumi.StringPtrOutput)
+}
+
+// The ARN of the role to assume.
+func (o CosAssumeRolePtrOutput) RoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CosAssumeRole) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.RoleArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+func (o CosAssumeRolePtrOutput) SessionDuration() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *CosAssumeRole) *int {
+		if v == nil {
+			return nil
+		}
+		return v.SessionDuration
+	}).(pulumi.IntPtrOutput)
+}
+
+// The session name to use when assuming the role.
+func (o CosAssumeRolePtrOutput) SessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CosAssumeRole) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.SessionName
+	}).(pulumi.StringPtrOutput)
+}
+
+type GcsDefaults struct {
+	// The default temporary OAuth 2.0 access token.
+	AccessToken *string `pulumi:"accessToken"`
+	// The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+	Credentials *string `pulumi:"credentials"`
+	// The service account to impersonate by default.
+	ImpersonateServiceAccount *string `pulumi:"impersonateServiceAccount"`
+	// The default delegation chain for impersonating impersonateServiceAccount.
+	ImpersonateServiceAccountDelegates []string `pulumi:"impersonateServiceAccountDelegates"`
+	// The default custom endpoint for the Cloud Storage API.
+	StorageCustomEndpoint *string `pulumi:"storageCustomEndpoint"`
+}
+
+// GcsDefaultsInput is an input type that accepts GcsDefaultsArgs and GcsDefaultsOutput values.
+// You can construct a concrete instance of `GcsDefaultsInput` via:
+//
+//	GcsDefaultsArgs{...}
+type GcsDefaultsInput interface {
+	pulumi.Input
+
+	ToGcsDefaultsOutput() GcsDefaultsOutput
+	ToGcsDefaultsOutputWithContext(context.Context) GcsDefaultsOutput
+}
+
+type GcsDefaultsArgs struct {
+	// The default temporary OAuth 2.0 access token.
+	AccessToken pulumi.StringPtrInput `pulumi:"accessToken"`
+	// The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+	Credentials pulumi.StringPtrInput `pulumi:"credentials"`
+	// The service account to impersonate by default.
+	ImpersonateServiceAccount pulumi.StringPtrInput `pulumi:"impersonateServiceAccount"`
+	// The default delegation chain for impersonating impersonateServiceAccount.
+	ImpersonateServiceAccountDelegates pulumi.StringArrayInput `pulumi:"impersonateServiceAccountDelegates"`
+	// The default custom endpoint for the Cloud Storage API.
+	StorageCustomEndpoint pulumi.StringPtrInput `pulumi:"storageCustomEndpoint"`
+}
+
+func (GcsDefaultsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GcsDefaults)(nil)).Elem()
+}
+
+func (i GcsDefaultsArgs) ToGcsDefaultsOutput() GcsDefaultsOutput {
+	return i.ToGcsDefaultsOutputWithContext(context.Background())
+}
+
+func (i GcsDefaultsArgs) ToGcsDefaultsOutputWithContext(ctx context.Context) GcsDefaultsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GcsDefaultsOutput)
+}
+
+func (i GcsDefaultsArgs) ToGcsDefaultsPtrOutput() GcsDefaultsPtrOutput {
+	return i.ToGcsDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i GcsDefaultsArgs) ToGcsDefaultsPtrOutputWithContext(ctx context.Context) GcsDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GcsDefaultsOutput).ToGcsDefaultsPtrOutputWithContext(ctx)
+}
+
+// GcsDefaultsPtrInput is an input type that accepts GcsDefaultsArgs, GcsDefaultsPtr and GcsDefaultsPtrOutput values.
+// You can construct a concrete instance of `GcsDefaultsPtrInput` via:
+//
+//	        GcsDefaultsArgs{...}
+//
+//	or:
+//
+//	        nil
+type GcsDefaultsPtrInput interface {
+	pulumi.Input
+
+	ToGcsDefaultsPtrOutput() GcsDefaultsPtrOutput
+	ToGcsDefaultsPtrOutputWithContext(context.Context) GcsDefaultsPtrOutput
+}
+
+type gcsDefaultsPtrType GcsDefaultsArgs
+
+func GcsDefaultsPtr(v *GcsDefaultsArgs) GcsDefaultsPtrInput {
+	return (*gcsDefaultsPtrType)(v)
+}
+
+func (*gcsDefaultsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**GcsDefaults)(nil)).Elem()
+}
+
+func (i *gcsDefaultsPtrType) ToGcsDefaultsPtrOutput() GcsDefaultsPtrOutput {
+	return i.ToGcsDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i *gcsDefaultsPtrType) ToGcsDefaultsPtrOutputWithContext(ctx context.Context) GcsDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GcsDefaultsPtrOutput)
+}
+
+type GcsDefaultsOutput struct{ *pulumi.OutputState }
+
+func (GcsDefaultsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GcsDefaults)(nil)).Elem()
+}
+
+func (o GcsDefaultsOutput) ToGcsDefaultsOutput() GcsDefaultsOutput {
+	return o
+}
+
+func (o GcsDefaultsOutput) ToGcsDefaultsOutputWithContext(ctx context.Context) GcsDefaultsOutput {
+	return o
+}
+
+func (o GcsDefaultsOutput) ToGcsDefaultsPtrOutput() GcsDefaultsPtrOutput {
+	return o.ToGcsDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (o GcsDefaultsOutput) ToGcsDefaultsPtrOutputWithContext(ctx context.Context) GcsDefaultsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v GcsDefaults) *GcsDefaults {
+		return &v
+	}).(GcsDefaultsPtrOutput)
+}
+
+// The default temporary OAuth 2.0 access token.
+func (o GcsDefaultsOutput) AccessToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GcsDefaults) *string { return v.AccessToken }).(pulumi.StringPtrOutput)
+}
+
+// The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+func (o GcsDefaultsOutput) Credentials() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GcsDefaults) *string { return v.Credentials }).(pulumi.StringPtrOutput)
+}
+
+// The service account to impersonate by default.
+func (o GcsDefaultsOutput) ImpersonateServiceAccount() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GcsDefaults) *string { return v.ImpersonateServiceAccount }).(pulumi.StringPtrOutput)
+}
+
+// The default delegation chain for impersonating impersonateServiceAccount.
+func (o GcsDefaultsOutput) ImpersonateServiceAccountDelegates() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v GcsDefaults) []string { return v.ImpersonateServiceAccountDelegates }).(pulumi.StringArrayOutput)
+}
+
+// The default custom endpoint for the Cloud Storage API.
+func (o GcsDefaultsOutput) StorageCustomEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v GcsDefaults) *string { return v.StorageCustomEndpoint }).(pulumi.StringPtrOutput)
+}
+
+type GcsDefaultsPtrOutput struct{ *pulumi.OutputState }
+
+func (GcsDefaultsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**GcsDefaults)(nil)).Elem()
+}
+
+func (o GcsDefaultsPtrOutput) ToGcsDefaultsPtrOutput() GcsDefaultsPtrOutput {
+	return o
+}
+
+func (o GcsDefaultsPtrOutput) ToGcsDefaultsPtrOutputWithContext(ctx context.Context) GcsDefaultsPtrOutput {
+	return o
+}
+
+func (o GcsDefaultsPtrOutput) Elem() GcsDefaultsOutput {
+	return o.ApplyT(func(v *GcsDefaults) GcsDefaults {
+		if v != nil {
+			return *v
+		}
+		var ret GcsDefaults
+		return ret
+	}).(GcsDefaultsOutput)
+}
+
+// The default temporary OAuth 2.0 access token.
+func (o GcsDefaultsPtrOutput) AccessToken() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GcsDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AccessToken
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+func (o GcsDefaultsPtrOutput) Credentials() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GcsDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Credentials
+	}).(pulumi.StringPtrOutput)
+}
+
+// The service account to impersonate by default.
+func (o GcsDefaultsPtrOutput) ImpersonateServiceAccount() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GcsDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ImpersonateServiceAccount
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default delegation chain for impersonating impersonateServiceAccount.
+func (o GcsDefaultsPtrOutput) ImpersonateServiceAccountDelegates() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *GcsDefaults) []string {
+		if v == nil {
+			return nil
+		}
+		return v.ImpersonateServiceAccountDelegates
+	}).(pulumi.StringArrayOutput)
+}
+
+// The default custom endpoint for the Cloud Storage API.
+func (o GcsDefaultsPtrOutput) StorageCustomEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *GcsDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.StorageCustomEndpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+type KubernetesExec struct {
+	// The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+	ApiVersion string `pulumi:"apiVersion"`
+	// The arguments passed to the command.
+	Args []string `pulumi:"args"`
+	// The command to run.
+	Command string `pulumi:"command"`
+	// Additional environment variables for the command.
+	Env map[string]string `pulumi:"env"`
+}
+
+// KubernetesExecInput is an input type that accepts KubernetesExecArgs and KubernetesExecOutput values.
+// You can construct a concrete instance of `KubernetesExecInput` via:
+//
+//	KubernetesExecArgs{...}
+type KubernetesExecInput interface {
+	pulumi.Input
+
+	ToKubernetesExecOutput() KubernetesExecOutput
+	ToKubernetesExecOutputWithContext(context.Context) KubernetesExecOutput
+}
+
+type KubernetesExecArgs struct {
+	// The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+	ApiVersion pulumi.StringInput `pulumi:"apiVersion"`
+	// The arguments passed to the command.
+	Args pulumi.StringArrayInput `pulumi:"args"`
+	// The command to run.
+	Command pulumi.StringInput `pulumi:"command"`
+	// Additional environment variables for the command.
+	Env pulumi.StringMapInput `pulumi:"env"`
+}
+
+func (KubernetesExecArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubernetesExec)(nil)).Elem()
+}
+
+func (i KubernetesExecArgs) ToKubernetesExecOutput() KubernetesExecOutput {
+	return i.ToKubernetesExecOutputWithContext(context.Background())
+}
+
+func (i KubernetesExecArgs) ToKubernetesExecOutputWithContext(ctx context.Context) KubernetesExecOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesExecOutput)
+}
+
+func (i KubernetesExecArgs) ToKubernetesExecPtrOutput() KubernetesExecPtrOutput {
+	return i.ToKubernetesExecPtrOutputWithContext(context.Background())
+}
+
+func (i KubernetesExecArgs) ToKubernetesExecPtrOutputWithContext(ctx context.Context) KubernetesExecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesExecOutput).ToKubernetesExecPtrOutputWithContext(ctx)
+}
+
+// KubernetesExecPtrInput is an input type that accepts KubernetesExecArgs, KubernetesExecPtr and KubernetesExecPtrOutput values.
+// You can construct a concrete instance of `KubernetesExecPtrInput` via:
+//
+//	        KubernetesExecArgs{...}
+//
+//	or:
+//
+//	        nil
+type KubernetesExecPtrInput interface {
+	pulumi.Input
+
+	ToKubernetesExecPtrOutput() KubernetesExecPtrOutput
+	ToKubernetesExecPtrOutputWithContext(context.Context) KubernetesExecPtrOutput
+}
+
+type kubernetesExecPtrType KubernetesExecArgs
+
+func KubernetesExecPtr(v *KubernetesExecArgs) KubernetesExecPtrInput {
+	return (*kubernetesExecPtrType)(v)
+}
+
+func (*kubernetesExecPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubernetesExec)(nil)).Elem()
+}
+
+func (i *kubernetesExecPtrType) ToKubernetesExecPtrOutput() KubernetesExecPtrOutput {
+	return i.ToKubernetesExecPtrOutputWithContext(context.Background())
+}
+
+func (i *kubernetesExecPtrType) ToKubernetesExecPtrOutputWithContext(ctx context.Context) KubernetesExecPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(KubernetesExecPtrOutput)
+}
+
+type KubernetesExecOutput struct{ *pulumi.OutputState }
+
+func (KubernetesExecOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubernetesExec)(nil)).Elem()
+}
+
+func (o KubernetesExecOutput) ToKubernetesExecOutput() KubernetesExecOutput {
+	return o
+}
+
+func (o KubernetesExecOutput) ToKubernetesExecOutputWithContext(ctx context.Context) KubernetesExecOutput {
+	return o
+}
+
+func (o KubernetesExecOutput) ToKubernetesExecPtrOutput() KubernetesExecPtrOutput {
+	return o.ToKubernetesExecPtrOutputWithContext(context.Background())
+}
+
+func (o KubernetesExecOutput) ToKubernetesExecPtrOutputWithContext(ctx context.Context) KubernetesExecPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v KubernetesExec) *KubernetesExec {
+		return &v
+	}).(KubernetesExecPtrOutput)
+}
+
+// The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+func (o KubernetesExecOutput) ApiVersion() pulumi.StringOutput {
+	return o.ApplyT(func(v KubernetesExec) string { return v.ApiVersion }).(pulumi.StringOutput)
+}
+
+// The arguments passed to the command.
+func (o KubernetesExecOutput) Args() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v KubernetesExec) []string { return v.Args }).(pulumi.StringArrayOutput)
+}
+
+// The command to run.
+func (o KubernetesExecOutput) Command() pulumi.StringOutput {
+	return o.ApplyT(func(v KubernetesExec) string { return v.Command }).(pulumi.StringOutput)
+}
+
+// Additional environment variables for the command.
+func (o KubernetesExecOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v KubernetesExec) map[string]string { return v.Env }).(pulumi.StringMapOutput)
+}
+
+type KubernetesExecPtrOutput struct{ *pulumi.OutputState }
+
+func (KubernetesExecPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KubernetesExec)(nil)).Elem()
+}
+
+func (o KubernetesExecPtrOutput) ToKubernetesExecPtrOutput() KubernetesExecPtrOutput {
+	return o
+}
+
+func (o KubernetesExecPtrOutput) ToKubernetesExecPtrOutputWithContext(ctx context.Context) KubernetesExecPtrOutput {
+	return o
+}
+
+func (o KubernetesExecPtrOutput) Elem() KubernetesExecOutput {
+	return o.ApplyT(func(v *KubernetesExec) KubernetesExec {
+		if v != nil {
+			return *v
+		}
+		var ret KubernetesExec
+		return ret
+	}).(KubernetesExecOutput)
+}
+
+// The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+func (o KubernetesExecPtrOutput) ApiVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubernetesExec) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ApiVersion
+	}).(pulumi.StringPtrOutput)
+}
+
+// The arguments passed to the command.
+func (o KubernetesExecPtrOutput) Args() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *KubernetesExec) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Args
+	}).(pulumi.StringArrayOutput)
+}
+
+// The command to run.
+func (o KubernetesExecPtrOutput) Command() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KubernetesExec) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Command
+	}).(pulumi.StringPtrOutput)
+}
+
+// Additional environment variables for the command.
+func (o KubernetesExecPtrOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *KubernetesExec) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Env
+	}).(pulumi.StringMapOutput)
+}
+
+type RemoteDefaults struct {
+	// The default hostname to connect to.
+	Hostname *string `pulumi:"hostname"`
+	// The default token used to authenticate with the backend.
+	Token *string `pulumi:"token"`
+}
+
+// RemoteDefaultsInput is an input type that accepts RemoteDefaultsArgs and RemoteDefaultsOutput values.
+// You can construct a concrete instance of `RemoteDefaultsInput` via:
+//
+//	RemoteDefaultsArgs{...}
+type RemoteDefaultsInput interface {
+	pulumi.Input
+
+	ToRemoteDefaultsOutput() RemoteDefaultsOutput
+	ToRemoteDefaultsOutputWithContext(context.Context) RemoteDefaultsOutput
+}
+
+type RemoteDefaultsArgs struct {
+	// The default hostname to connect to.
+	Hostname pulumi.StringPtrInput `pulumi:"hostname"`
+	// The default token used to authenticate with the backend.
+	Token pulumi.StringPtrInput `pulumi:"token"`
+}
+
+func (RemoteDefaultsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RemoteDefaults)(nil)).Elem()
+}
+
+func (i RemoteDefaultsArgs) ToRemoteDefaultsOutput() RemoteDefaultsOutput {
+	return i.ToRemoteDefaultsOutputWithContext(context.Background())
+}
+
+func (i RemoteDefaultsArgs) ToRemoteDefaultsOutputWithContext(ctx context.Context) RemoteDefaultsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RemoteDefaultsOutput)
+}
+
+func (i RemoteDefaultsArgs) ToRemoteDefaultsPtrOutput() RemoteDefaultsPtrOutput {
+	return i.ToRemoteDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i RemoteDefaultsArgs) ToRemoteDefaultsPtrOutputWithContext(ctx context.Context) RemoteDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RemoteDefaultsOutput).ToRemoteDefaultsPtrOutputWithContext(ctx)
+}
+
+// RemoteDefaultsPtrInput is an input type that accepts RemoteDefaultsArgs, RemoteDefaultsPtr and RemoteDefaultsPtrOutput values.
+// You can construct a concrete instance of `RemoteDefaultsPtrInput` via:
+//
+//	        RemoteDefaultsArgs{...}
+//
+//	or:
+//
+//	        nil
+type RemoteDefaultsPtrInput interface {
+	pulumi.Input
+
+	ToRemoteDefaultsPtrOutput() RemoteDefaultsPtrOutput
+	ToRemoteDefaultsPtrOutputWithContext(context.Context) RemoteDefaultsPtrOutput
+}
+
+type remoteDefaultsPtrType RemoteDefaultsArgs
+
+func RemoteDefaultsPtr(v *RemoteDefaultsArgs) RemoteDefaultsPtrInput {
+	return (*remoteDefaultsPtrType)(v)
+}
+
+func (*remoteDefaultsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**RemoteDefaults)(nil)).Elem()
+}
+
+func (i *remoteDefaultsPtrType) ToRemoteDefaultsPtrOutput() RemoteDefaultsPtrOutput {
+	return i.ToRemoteDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i *remoteDefaultsPtrType) ToRemoteDefaultsPtrOutputWithContext(ctx context.Context) RemoteDefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RemoteDefaultsPtrOutput)
+}
+
+type RemoteDefaultsOutput struct{ *pulumi.OutputState }
+
+func (RemoteDefaultsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RemoteDefaults)(nil)).Elem()
+}
+
+func (o RemoteDefaultsOutput) ToRemoteDefaultsOutput() RemoteDefaultsOutput {
+	return o
+}
+
+func (o RemoteDefaultsOutput) ToRemoteDefaultsOutputWithContext(ctx context.Context) RemoteDefaultsOutput {
+	return o
+}
+
+func (o RemoteDefaultsOutput) ToRemoteDefaultsPtrOutput() RemoteDefaultsPtrOutput {
+	return o.ToRemoteDefaultsPtrOutputWithContext(context.Background())
+}
+
+func (o RemoteDefaultsOutput) ToRemoteDefaultsPtrOutputWithContext(ctx context.Context) RemoteDefaultsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v RemoteDefaults) *RemoteDefaults {
+		return &v
+	}).(RemoteDefaultsPtrOutput)
+}
+
+// The default hostname to connect to.
+func (o RemoteDefaultsOutput) Hostname() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RemoteDefaults) *string { return v.Hostname }).(pulumi.StringPtrOutput)
+}
+
+// The default token used to authenticate with the backend.
+func (o RemoteDefaultsOutput) Token() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RemoteDefaults) *string { return v.Token }).(pulumi.StringPtrOutput)
+}
+
+type RemoteDefaultsPtrOutput struct{ *pulumi.OutputState }
+
+func (RemoteDefaultsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RemoteDefaults)(nil)).Elem()
+}
+
+func (o RemoteDefaultsPtrOutput) ToRemoteDefaultsPtrOutput() RemoteDefaultsPtrOutput {
+	return o
+}
+
+func (o RemoteDefaultsPtrOutput) ToRemoteDefaultsPtrOutputWithContext(ctx context.Context) RemoteDefaultsPtrOutput {
+	return o
+}
+
+func (o RemoteDefaultsPtrOutput) Elem() RemoteDefaultsOutput {
+	return o.ApplyT(func(v *RemoteDefaults) RemoteDefaults {
+		if v != nil {
+			return *v
+		}
+		var ret RemoteDefaults
+		return ret
+	}).(RemoteDefaultsOutput)
+}
+
+// The default hostname to connect to.
+func (o RemoteDefaultsPtrOutput) Hostname() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RemoteDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Hostname
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default token used to authenticate with the backend.
+func (o RemoteDefaultsPtrOutput) Token() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RemoteDefaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Token
+	}).(pulumi.StringPtrOutput)
+}
+
+type S3Defaults struct {
+	// The default AWS access key.
+	AccessKey *string `pulumi:"accessKey"`
+	// The default duration, in seconds, of the assume role session.
+	AssumeRoleDurationSeconds *int `pulumi:"assumeRoleDurationSeconds"`
+	// The default custom endpoint for the S3 API.
+	Endpoint *string `pulumi:"endpoint"`
+	// The default external ID to use when assuming the role.
+	ExternalId *string `pulumi:"externalId"`
+	// Whether to force path-style addressing by default.
+	ForcePathStyle *bool `pulumi:"forcePathStyle"`
+	// The default custom endpoint for the IAM API.
+	IamEndpoint *string `pulumi:"iamEndpoint"`
+	// The default maximum number of times an AWS API request is retried.
+	MaxRetries *int `pulumi:"maxRetries"`
+	// The default AWS profile name.
+	Profile *string `pulumi:"profile"`
+	// The default AWS region of the S3 bucket.
+	Region *string `pulumi:"region"`
+	// The ARN of the IAM Role assumed by default in order to read the state.
+	RoleArn *string `pulumi:"roleArn"`
+	// The default AWS secret key.
+	SecretKey *string `pulumi:"secretKey"`
+	// The default session name to use when assuming the role.
+	SessionName *string `pulumi:"sessionName"`
+	// The default path to a shared credentials file.
+	SharedCredentialsFile *string `pulumi:"sharedCredentialsFile"`
+	// Whether to skip the credentials validation by default.
+	SkipCredentialsValidation *bool `pulumi:"skipCredentialsValidation"`
+	// Whether to skip the AWS Metadata API check by default.
+	SkipMetadataApiCheck *bool `pulumi:"skipMetadataApiCheck"`
+	// Whether to skip static validation of the region name by default.
+	SkipRegionValidation *bool `pulumi:"skipRegionValidation"`
+	// The default custom endpoint for the STS API.
+	StsEndpoint *string `pulumi:"stsEndpoint"`
+	// The default AWS session token.
+	Token *string `pulumi:"token"`
+}
+
+// S3DefaultsInput is an input type that accepts S3DefaultsArgs and S3DefaultsOutput values.
+// You can construct a concrete instance of `S3DefaultsInput` via:
+//
+//	S3DefaultsArgs{...}
+type S3DefaultsInput interface {
+	pulumi.Input
+
+	ToS3DefaultsOutput() S3DefaultsOutput
+	ToS3DefaultsOutputWithContext(context.Context) S3DefaultsOutput
+}
+
+type S3DefaultsArgs struct {
+	// The default AWS access key.
+	AccessKey pulumi.StringPtrInput `pulumi:"accessKey"`
+	// The default duration, in seconds, of the assume role session.
+	AssumeRoleDurationSeconds pulumi.IntPtrInput `pulumi:"assumeRoleDurationSeconds"`
+	// The default custom endpoint for the S3 API.
+	Endpoint pulumi.StringPtrInput `pulumi:"endpoint"`
+	// The default external ID to use when assuming the role.
+	ExternalId pulumi.StringPtrInput `pulumi:"externalId"`
+	// Whether to force path-style addressing by default.
+	ForcePathStyle pulumi.BoolPtrInput `pulumi:"forcePathStyle"`
+	// The default custom endpoint for the IAM API.
+	IamEndpoint pulumi.StringPtrInput `pulumi:"iamEndpoint"`
+	// The default maximum number of times an AWS API request is retried.
+	MaxRetries pulumi.IntPtrInput `pulumi:"maxRetries"`
+	// The default AWS profile name.
+	Profile pulumi.StringPtrInput `pulumi:"profile"`
+	// The default AWS region of the S3 bucket.
+	Region pulumi.StringPtrInput `pulumi:"region"`
+	// The ARN of the IAM Role assumed by default in order to read the state.
+	RoleArn pulumi.StringPtrInput `pulumi:"roleArn"`
+	// The default AWS secret key.
+	SecretKey pulumi.StringPtrInput `pulumi:"secretKey"`
+	// The default session name to use when assuming the role.
+	SessionName pulumi.StringPtrInput `pulumi:"sessionName"`
+	// The default path to a shared credentials file.
+	SharedCredentialsFile pulumi.StringPtrInput `pulumi:"sharedCredentialsFile"`
+	// Whether to skip the credentials validation by default.
+	SkipCredentialsValidation pulumi.BoolPtrInput `pulumi:"skipCredentialsValidation"`
+	// Whether to skip the AWS Metadata API check by default.
+	SkipMetadataApiCheck pulumi.BoolPtrInput `pulumi:"skipMetadataApiCheck"`
+	// Whether to skip static validation of the region name by default.
+	SkipRegionValidation pulumi.BoolPtrInput `pulumi:"skipRegionValidation"`
+	// The default custom endpoint for the STS API.
+	StsEndpoint pulumi.StringPtrInput `pulumi:"stsEndpoint"`
+	// The default AWS session token.
+	Token pulumi.StringPtrInput `pulumi:"token"`
+}
+
+func (S3DefaultsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*S3Defaults)(nil)).Elem()
+}
+
+func (i S3DefaultsArgs) ToS3DefaultsOutput() S3DefaultsOutput {
+	return i.ToS3DefaultsOutputWithContext(context.Background())
+}
+
+func (i S3DefaultsArgs) ToS3DefaultsOutputWithContext(ctx context.Context) S3DefaultsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3DefaultsOutput)
+}
+
+func (i S3DefaultsArgs) ToS3DefaultsPtrOutput() S3DefaultsPtrOutput {
+	return i.ToS3DefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i S3DefaultsArgs) ToS3DefaultsPtrOutputWithContext(ctx context.Context) S3DefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3DefaultsOutput).ToS3DefaultsPtrOutputWithContext(ctx)
+}
+
+// S3DefaultsPtrInput is an input type that accepts S3DefaultsArgs, S3DefaultsPtr and S3DefaultsPtrOutput values.
+// You can construct a concrete instance of `S3DefaultsPtrInput` via:
+//
+//	        S3DefaultsArgs{...}
+//
+//	or:
+//
+//	        nil
+type S3DefaultsPtrInput interface {
+	pulumi.Input
+
+	ToS3DefaultsPtrOutput() S3DefaultsPtrOutput
+	ToS3DefaultsPtrOutputWithContext(context.Context) S3DefaultsPtrOutput
+}
+
+type s3defaultsPtrType S3DefaultsArgs
+
+func S3DefaultsPtr(v *S3DefaultsArgs) S3DefaultsPtrInput {
+	return (*s3defaultsPtrType)(v)
+}
+
+func (*s3defaultsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**S3Defaults)(nil)).Elem()
+}
+
+func (i *s3defaultsPtrType) ToS3DefaultsPtrOutput() S3DefaultsPtrOutput {
+	return i.ToS3DefaultsPtrOutputWithContext(context.Background())
+}
+
+func (i *s3defaultsPtrType) ToS3DefaultsPtrOutputWithContext(ctx context.Context) S3DefaultsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(S3DefaultsPtrOutput)
+}
+
+type S3DefaultsOutput struct{ *pulumi.OutputState }
+
+func (S3DefaultsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*S3Defaults)(nil)).Elem()
+}
+
+func (o S3DefaultsOutput) ToS3DefaultsOutput() S3DefaultsOutput {
+	return o
+}
+
+func (o S3DefaultsOutput) ToS3DefaultsOutputWithContext(ctx context.Context) S3DefaultsOutput {
+	return o
+}
+
+func (o S3DefaultsOutput) ToS3DefaultsPtrOutput() S3DefaultsPtrOutput {
+	return o.ToS3DefaultsPtrOutputWithContext(context.Background())
+}
+
+func (o S3DefaultsOutput) ToS3DefaultsPtrOutputWithContext(ctx context.Context) S3DefaultsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v S3Defaults) *S3Defaults {
+		return &v
+	}).(S3DefaultsPtrOutput)
+}
+
+// The default AWS access key.
+func (o S3DefaultsOutput) AccessKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.AccessKey }).(pulumi.StringPtrOutput)
+}
+
+// The default duration, in seconds, of the assume role session.
+func (o S3DefaultsOutput) AssumeRoleDurationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *int { return v.AssumeRoleDurationSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The default custom endpoint for the S3 API.
+func (o S3DefaultsOutput) Endpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.Endpoint }).(pulumi.StringPtrOutput)
+}
+
+// The default external ID to use when assuming the role.
+func (o S3DefaultsOutput) ExternalId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.ExternalId }).(pulumi.StringPtrOutput)
+}
+
+// Whether to force path-style addressing by default.
+func (o S3DefaultsOutput) ForcePathStyle() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *bool { return v.ForcePathStyle }).(pulumi.BoolPtrOutput)
+}
+
+// The default custom endpoint for the IAM API.
+func (o S3DefaultsOutput) IamEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.IamEndpoint }).(pulumi.StringPtrOutput)
+}
+
+// The default maximum number of times an AWS API request is retried.
+func (o S3DefaultsOutput) MaxRetries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *int { return v.MaxRetries }).(pulumi.IntPtrOutput)
+}
+
+// The default AWS profile name.
+func (o S3DefaultsOutput) Profile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.Profile }).(pulumi.StringPtrOutput)
+}
+
+// The default AWS region of the S3 bucket.
+func (o S3DefaultsOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.Region }).(pulumi.StringPtrOutput)
+}
+
+// The ARN of the IAM Role assumed by default in order to read the state.
+func (o S3DefaultsOutput) RoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.RoleArn }).(pulumi.StringPtrOutput)
+}
+
+// The default AWS secret key.
+func (o S3DefaultsOutput) SecretKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.SecretKey }).(pulumi.StringPtrOutput)
+}
+
+// The default session name to use when assuming the role.
+func (o S3DefaultsOutput) SessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.SessionName }).(pulumi.StringPtrOutput)
+}
+
+// The default path to a shared credentials file.
+func (o S3DefaultsOutput) SharedCredentialsFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.SharedCredentialsFile }).(pulumi.StringPtrOutput)
+}
+
+// Whether to skip the credentials validation by default.
+func (o S3DefaultsOutput) SkipCredentialsValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *bool { return v.SkipCredentialsValidation }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to skip the AWS Metadata API check by default.
+func (o S3DefaultsOutput) SkipMetadataApiCheck() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *bool { return v.SkipMetadataApiCheck }).(pulumi.BoolPtrOutput)
+}
+
+// Whether to skip static validation of the region name by default.
+func (o S3DefaultsOutput) SkipRegionValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *bool { return v.SkipRegionValidation }).(pulumi.BoolPtrOutput)
+}
+
+// The default custom endpoint for the STS API.
+func (o S3DefaultsOutput) StsEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.StsEndpoint }).(pulumi.StringPtrOutput)
+}
+
+// The default AWS session token.
+func (o S3DefaultsOutput) Token() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v S3Defaults) *string { return v.Token }).(pulumi.StringPtrOutput)
+}
+
+type S3DefaultsPtrOutput struct{ *pulumi.OutputState }
+
+func (S3DefaultsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**S3Defaults)(nil)).Elem()
+}
+
+func (o S3DefaultsPtrOutput) ToS3DefaultsPtrOutput() S3DefaultsPtrOutput {
+	return o
+}
+
+func (o S3DefaultsPtrOutput) ToS3DefaultsPtrOutputWithContext(ctx context.Context) S3DefaultsPtrOutput {
+	return o
+}
+
+func (o S3DefaultsPtrOutput) Elem() S3DefaultsOutput {
+	return o.ApplyT(func(v *S3Defaults) S3Defaults {
+		if v != nil {
+			return *v
+		}
+		var ret S3Defaults
+		return ret
+	}).(S3DefaultsOutput)
+}
+
+// The default AWS access key.
+func (o S3DefaultsPtrOutput) AccessKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AccessKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default duration, in seconds, of the assume role session.
+func (o S3DefaultsPtrOutput) AssumeRoleDurationSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *int {
+		if v == nil {
+			return nil
+		}
+		return v.AssumeRoleDurationSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// The default custom endpoint for the S3 API.
+func (o S3DefaultsPtrOutput) Endpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Endpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default external ID to use when assuming the role.
+func (o S3DefaultsPtrOutput) ExternalId() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ExternalId
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to force path-style addressing by default.
+func (o S3DefaultsPtrOutput) ForcePathStyle() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ForcePathStyle
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The default custom endpoint for the IAM API.
+func (o S3DefaultsPtrOutput) IamEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.IamEndpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default maximum number of times an AWS API request is retried.
+func (o S3DefaultsPtrOutput) MaxRetries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxRetries
+	}).(pulumi.IntPtrOutput)
+}
+
+// The default AWS profile name.
+func (o S3DefaultsPtrOutput) Profile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Profile
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default AWS region of the S3 bucket.
+func (o S3DefaultsPtrOutput) Region() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Region
+	}).(pulumi.StringPtrOutput)
+}
+
+// The ARN of the IAM Role assumed by default in order to read the state.
+func (o S3DefaultsPtrOutput) RoleArn() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.RoleArn
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default AWS secret key.
+func (o S3DefaultsPtrOutput) SecretKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SecretKey
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default session name to use when assuming the role.
+func (o S3DefaultsPtrOutput) SessionName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SessionName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default path to a shared credentials file.
+func (o S3DefaultsPtrOutput) SharedCredentialsFile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SharedCredentialsFile
+	}).(pulumi.StringPtrOutput)
+}
+
+// Whether to skip the credentials validation by default.
+func (o S3DefaultsPtrOutput) SkipCredentialsValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.SkipCredentialsValidation
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to skip the AWS Metadata API check by default.
+func (o S3DefaultsPtrOutput) SkipMetadataApiCheck() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.SkipMetadataApiCheck
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Whether to skip static validation of the region name by default.
+func (o S3DefaultsPtrOutput) SkipRegionValidation() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.SkipRegionValidation
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The default custom endpoint for the STS API.
+func (o S3DefaultsPtrOutput) StsEndpoint() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.StsEndpoint
+	}).(pulumi.StringPtrOutput)
+}
+
+// The default AWS session token.
+func (o S3DefaultsPtrOutput) Token() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *S3Defaults) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Token
+	}).(pulumi.StringPtrOutput)
+}
+
+// The result of fetching from a Terraform state store.
+type StateReferenceOutputs struct {
+	// The lineage of the state snapshot that was read, assigned when the state was first created.
+	Lineage string `pulumi:"lineage"`
+	// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Outputs map[string]interface{} `pulumi:"outputs"`
+	// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+	Resources []StateResource `pulumi:"resources"`
+	// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+	Serial int `pulumi:"serial"`
+	// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+	StateFormatVersion *int `pulumi:"stateFormatVersion"`
+	// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+	TerraformVersion *string `pulumi:"terraformVersion"`
+}
+
+// The result of fetching from a Terraform state store.
+type StateReferenceOutputsOutput struct{ *pulumi.OutputState }
+
+func (StateReferenceOutputsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StateReferenceOutputs)(nil)).Elem()
+}
+
+func (o StateReferenceOutputsOutput) ToStateReferenceOutputsOutput() StateReferenceOutputsOutput {
+	return o
+}
+
+func (o StateReferenceOutputsOutput) ToStateReferenceOutputsOutputWithContext(ctx context.Context) StateReferenceOutputsOutput {
+	return o
+}
+
+// The lineage of the state snapshot that was read, assigned when the state was first created.
+func (o StateReferenceOutputsOutput) Lineage() pulumi.StringOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) string { return v.Lineage }).(pulumi.StringOutput)
+}
+
+// The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o StateReferenceOutputsOutput) Outputs() pulumi.MapOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) map[string]interface{} { return v.Outputs }).(pulumi.MapOutput)
+}
+
+// The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+func (o StateReferenceOutputsOutput) Resources() StateResourceArrayOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) []StateResource { return v.Resources }).(StateResourceArrayOutput)
+}
+
+// The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+func (o StateReferenceOutputsOutput) Serial() pulumi.IntOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) int { return v.Serial }).(pulumi.IntOutput)
+}
+
+// The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+func (o StateReferenceOutputsOutput) StateFormatVersion() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) *int { return v.StateFormatVersion }).(pulumi.IntPtrOutput)
+}
+
+// The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+func (o StateReferenceOutputsOutput) TerraformVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v StateReferenceOutputs) *string { return v.TerraformVersion }).(pulumi.StringPtrOutput)
+}
+
+type StateReferenceOutputsMapOutput struct{ *pulumi.OutputState }
+
+func (StateReferenceOutputsMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]StateReferenceOutputs)(nil)).Elem()
+}
+
+func (o StateReferenceOutputsMapOutput) ToStateReferenceOutputsMapOutput() StateReferenceOutputsMapOutput {
+	return o
+}
+
+func (o StateReferenceOutputsMapOutput) ToStateReferenceOutputsMapOutputWithContext(ctx context.Context) StateReferenceOutputsMapOutput {
+	return o
+}
+
+func (o StateReferenceOutputsMapOutput) MapIndex(k pulumi.StringInput) StateReferenceOutputsOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) StateReferenceOutputs {
+		return vs[0].(map[string]StateReferenceOutputs)[vs[1].(string)]
+	}).(StateReferenceOutputsOutput)
+}
+
+type StateResource struct {
+	// The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+	Address string `pulumi:"address"`
+	// The attributes of the instance, as recorded in state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+	Attributes map[string]interface{} `pulumi:"attributes"`
+	// The count index or for_each key of the instance. Unset when the resource uses neither count nor for_each.
+	IndexKey interface{} `pulumi:"indexKey"`
+	// managed for resources and data for data sources.
+	Mode string `pulumi:"mode"`
+	// The address of the module instance holding the resource. Empty for the root module.
+	Module string `pulumi:"module"`
+	// The name of the resource in its module, e.g. private.
+	Name string `pulumi:"name"`
+	// The provider configuration that last managed the resource, e.g. provider["registry.terraform.io/hashicorp/aws"].
+	Provider string `pulumi:"provider"`
+	// The resource type, e.g. aws_subnet.
+	Type string `pulumi:"type"`
+}
+
+type StateResourceOutput struct{ *pulumi.OutputState }
+
+func (StateResourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*StateResource)(nil)).Elem()
+}
+
+func (o StateResourceOutput) ToStateResourceOutput() StateResourceOutput {
+	return o
+}
+
+func (o StateResourceOutput) ToStateResourceOutputWithContext(ctx context.Context) StateResourceOutput {
+	return o
+}
+
+// The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+func (o StateResourceOutput) Address() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Address }).(pulumi.StringOutput)
+}
+
+// The attributes of the instance, as recorded in state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+func (o StateResourceOutput) Attributes() pulumi.MapOutput {
+	return o.ApplyT(func(v StateResource) map[string]interface{} { return v.Attributes }).(pulumi.MapOutput)
+}
+
+// The count index or for_each key of the instance. Unset when the resource uses neither count nor for_each.
+func (o StateResourceOutput) IndexKey() pulumi.AnyOutput {
+	return o.ApplyT(func(v StateResource) interface{} { return v.IndexKey }).(pulumi.AnyOutput)
+}
+
+// managed for resources and data for data sources.
+func (o StateResourceOutput) Mode() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Mode }).(pulumi.StringOutput)
+}
+
+// The address of the module instance holding the resource. Empty for the root module.
+func (o StateResourceOutput) Module() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Module }).(pulumi.StringOutput)
+}
+
+// The name of the resource in its module, e.g. private.
+func (o StateResourceOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The provider configuration that last managed the resource, e.g. provider["registry.terraform.io/hashicorp/aws"].
+func (o StateResourceOutput) Provider() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Provider }).(pulumi.StringOutput)
+}
+
+// The resource type, e.g. aws_subnet.
+func (o StateResourceOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v StateResource) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type StateResourceArrayOutput struct{ *pulumi.OutputState }
+
+func (StateResourceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]StateResource)(nil)).Elem()
+}
+
+func (o StateResourceArrayOutput) ToStateResourceArrayOutput() StateResourceArrayOutput {
+	return o
+}
+
+func (o StateResourceArrayOutput) ToStateResourceArrayOutputWithContext(ctx context.Context) StateResourceArrayOutput {
+	return o
+}
+
+func (o StateResourceArrayOutput) Index(i pulumi.IntInput) StateResourceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) StateResource {
+		return vs[0].([]StateResource)[vs[1].(int)]
+	}).(StateResourceOutput)
+}
+
 type Workspaces struct {
 	// The full name of one remote workspace. When configured, only the default workspace can be used. This option conflicts with prefix.
 	Name *string `pulumi:"name"`
@@ -75,6 +1968,36 @@ func (o WorkspacesOutput) Prefix() pulumi.StringPtrOutput {
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AzureRMDefaultsInput)(nil)).Elem(), AzureRMDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AzureRMDefaultsPtrInput)(nil)).Elem(), AzureRMDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CloudWorkspacesInput)(nil)).Elem(), CloudWorkspacesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CosAssumeRoleInput)(nil)).Elem(), CosAssumeRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CosAssumeRolePtrInput)(nil)).Elem(), CosAssumeRoleArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GcsDefaultsInput)(nil)).Elem(), GcsDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GcsDefaultsPtrInput)(nil)).Elem(), GcsDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*KubernetesExecInput)(nil)).Elem(), KubernetesExecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*KubernetesExecPtrInput)(nil)).Elem(), KubernetesExecArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RemoteDefaultsInput)(nil)).Elem(), RemoteDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RemoteDefaultsPtrInput)(nil)).Elem(), RemoteDefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*S3DefaultsInput)(nil)).Elem(), S3DefaultsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*S3DefaultsPtrInput)(nil)).Elem(), S3DefaultsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*WorkspacesInput)(nil)).Elem(), WorkspacesArgs{})
+	pulumi.RegisterOutputType(AzureRMDefaultsOutput{})
+	pulumi.RegisterOutputType(AzureRMDefaultsPtrOutput{})
+	pulumi.RegisterOutputType(CloudWorkspacesOutput{})
+	pulumi.RegisterOutputType(CosAssumeRoleOutput{})
+	pulumi.RegisterOutputType(CosAssumeRolePtrOutput{})
+	pulumi.RegisterOutputType(GcsDefaultsOutput{})
+	pulumi.RegisterOutputType(GcsDefaultsPtrOutput{})
+	pulumi.RegisterOutputType(KubernetesExecOutput{})
+	pulumi.RegisterOutputType(KubernetesExecPtrOutput{})
+	pulumi.RegisterOutputType(RemoteDefaultsOutput{})
+	pulumi.RegisterOutputType(RemoteDefaultsPtrOutput{})
+	pulumi.RegisterOutputType(S3DefaultsOutput{})
+	pulumi.RegisterOutputType(S3DefaultsPtrOutput{})
+	pulumi.RegisterOutputType(StateReferenceOutputsOutput{})
+	pulumi.RegisterOutputType(StateReferenceOutputsMapOutput{})
+	pulumi.RegisterOutputType(StateResourceOutput{})
+	pulumi.RegisterOutputType(StateResourceArrayOutput{})
 	pulumi.RegisterOutputType(WorkspacesOutput{})
 }
@@ -0,0 +1,110 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+declare var exports: any;
+const __config = new pulumi.Config("terraform");
+
+/**
+ * Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+ */
+export declare const azurermDefaults: outputs.state.AzureRMDefaults | undefined;
+Object.defineProperty(exports, "azurermDefaults", {
+    get() {
+        return __config.getObject<outputs.state.AzureRMDefaults>("azurermDefaults");
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+ */
+export declare const cacheStateReads: boolean | undefined;
+Object.defineProperty(exports, "cacheStateReads", {
+    get() {
+        return __config.getObject<boolean>("cacheStateReads");
+    },
+    enumerable: true,
+});
+
+/**
+ * How long a cached state is reused, as a duration such as 30s or 5m.
+ */
+export declare const cacheTtl: string;
+Object.defineProperty(exports, "cacheTtl", {
+    get() {
+        return __config.get("cacheTtl") ?? "5m";
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+ */
+export declare const disableEnvFallback: boolean | undefined;
+Object.defineProperty(exports, "disableEnvFallback", {
+    get() {
+        return __config.getObject<boolean>("disableEnvFallback");
+    },
+    enumerable: true,
+});
+
+/**
+ * Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+ */
+export declare const gcsDefaults: outputs.state.GcsDefaults | undefined;
+Object.defineProperty(exports, "gcsDefaults", {
+    get() {
+        return __config.getObject<outputs.state.GcsDefaults>("gcsDefaults");
+    },
+    enumerable: true,
+});
+
+/**
+ * Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+ */
+export declare const remoteDefaults: outputs.state.RemoteDefaults | undefined;
+Object.defineProperty(exports, "remoteDefaults", {
+    get() {
+        return __config.getObject<outputs.state.RemoteDefaults>("remoteDefaults");
+    },
+    enumerable: true,
+});
+
+/**
+ * Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+ */
+export declare const s3Defaults: outputs.state.S3Defaults | undefined;
+Object.defineProperty(exports, "s3Defaults", {
+    get() {
+        return __config.getObject<outputs.state.S3Defaults>("s3Defaults");
+    },
+    enumerable: true,
+});
+
+/**
+ * Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+ */
+export declare const showTerraformLogs: boolean;
+Object.defineProperty(exports, "showTerraformLogs", {
+    get() {
+        return __config.getObject<boolean>("showTerraformLogs") ?? true;
+    },
+    enumerable: true,
+});
+
+/**
+ * The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+ */
+export declare const timeout: string | undefined;
+Object.defineProperty(exports, "timeout", {
+    get() {
+        return __config.get("timeout");
+    },
+    enumerable: true,
+});
+
@@ -12,10 +12,12 @@ utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
 
 // Export sub-modules:
+import * as config from "./config";
 import * as state from "./state";
 import * as types from "./types";
 
 export {
+    config,
     state,
     types,
 };
@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 export class Provider extends pulumi.ProviderResource {
@@ -19,6 +21,14 @@ export class Provider extends pulumi.ProviderResource {
         return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
     }
 
+    /**
+     * How long a cached state is reused, as a duration such as 30s or 5m.
+     */
+    declare public readonly cacheTtl: pulumi.Output<string | undefined>;
+    /**
+     * The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+     */
+    declare public readonly timeout: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -31,6 +41,15 @@ export class Provider extends pulumi.ProviderResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            resourceInputs["azurermDefaults"] = pulumi.output(args?.azurermDefaults).apply(JSON.stringify);
+            resourceInputs["cacheStateReads"] = pulumi.output(args?.cacheStateReads).apply(JSON.stringify);
+            resourceInputs["cacheTtl"] = (args?.cacheTtl) ?? "5m";
+            resourceInputs["disableEnvFallback"] = pulumi.output(args?.disableEnvFallback).apply(JSON.stringify);
+            resourceInputs["gcsDefaults"] = pulumi.output(args?.gcsDefaults).apply(JSON.stringify);
+            resourceInputs["remoteDefaults"] = pulumi.output(args?.remoteDefaults).apply(JSON.stringify);
+            resourceInputs["s3Defaults"] = pulumi.output(args?.s3Defaults).apply(JSON.stringify);
+            resourceInputs["showTerraformLogs"] = pulumi.output((args?.showTerraformLogs) ?? true).apply(JSON.stringify);
+            resourceInputs["timeout"] = args?.timeout;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
@@ -41,4 +60,40 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+     */
+    azurermDefaults?: pulumi.Input<inputs.state.AzureRMDefaultsArgs | undefined>;
+    /**
+     * Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+     */
+    cacheStateReads?: pulumi.Input<boolean | undefined>;
+    /**
+     * How long a cached state is reused, as a duration such as 30s or 5m.
+     */
+    cacheTtl?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+     */
+    disableEnvFallback?: pulumi.Input<boolean | undefined>;
+    /**
+     * Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+     */
+    gcsDefaults?: pulumi.Input<inputs.state.GcsDefaultsArgs | undefined>;
+    /**
+     * Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+     */
+    remoteDefaults?: pulumi.Input<inputs.state.RemoteDefaultsArgs | undefined>;
+    /**
+     * Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+     */
+    s3Defaults?: pulumi.Input<inputs.state.S3DefaultsArgs | undefined>;
+    /**
+     * Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+     */
+    showTerraformLogs?: pulumi.Input<boolean | undefined>;
+    /**
+     * The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+     */
+    timeout?: pulumi.Input<string | undefined>;
 }
@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
 import * as utilities from "../utilities";
 
 /**
@@ -17,7 +19,9 @@ export function getAzureRMReference(args: GetAzureRMReferenceArgs, opts?: pulumi
         "clientSecret": args.clientSecret,
         "containerName": args.containerName,
         "endpoint": args.endpoint,
+        "env": args.env,
         "environment": args.environment,
+        "includeResources": args.includeResources,
         "key": args.key,
         "metadataHost": args.metadataHost,
         "msiEndpoint": args.msiEndpoint,
@@ -25,11 +29,15 @@ export function getAzureRMReference(args: GetAzureRMReferenceArgs, opts?: pulumi
         "oidcRequestUrl": args.oidcRequestUrl,
         "oidcToken": args.oidcToken,
         "oidcTokenFilePath": args.oidcTokenFilePath,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
         "resourceGroupName": args.resourceGroupName,
+        "resources": args.resources,
         "sasToken": args.sasToken,
         "storageAccountName": args.storageAccountName,
         "subscriptionId": args.subscriptionId,
         "tenantId": args.tenantId,
+        "timeout": args.timeout,
         "useAzureadAuth": args.useAzureadAuth,
         "useMsi": args.useMsi,
         "useOidc": args.useOidc,
@@ -66,10 +74,18 @@ export interface GetAzureRMReferenceArgs {
      * A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
      */
     endpoint?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
     /**
      * The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
      */
     environment?: string;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
     /**
      * The name of the blob holding the Terraform state file inside the storage container.
      */
@@ -98,10 +114,22 @@ export interface GetAzureRMReferenceArgs {
      * The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
      */
     oidcTokenFilePath?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
     /**
      * The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
      */
     resourceGroupName?: string;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
     /**
      * A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
      */
@@ -118,6 +146,10 @@ export interface GetAzureRMReferenceArgs {
      * The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
      */
     tenantId?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
     /**
      * Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
      */
@@ -141,9 +173,29 @@ export interface GetAzureRMReferenceArgs {
  */
 export interface GetAzureRMReferenceResult {
     /**
-     * The outputs displayed from Terraform state.
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
      */
     readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
 }
 /**
  * Access state stored in an Azure Blob Storage container.
@@ -158,7 +210,9 @@ export function getAzureRMReferenceOutput(args: GetAzureRMReferenceOutputArgs, o
         "clientSecret": args.clientSecret,
         "containerName": args.containerName,
         "endpoint": args.endpoint,
+        "env": args.env,
         "environment": args.environment,
+        "includeResources": args.includeResources,
         "key": args.key,
         "metadataHost": args.metadataHost,
         "msiEndpoint": args.msiEndpoint,
@@ -166,11 +220,15 @@ export function getAzureRMReferenceOutput(args: GetAzureRMReferenceOutputArgs, o
         "oidcRequestUrl": args.oidcRequestUrl,
         "oidcToken": args.oidcToken,
         "oidcTokenFilePath": args.oidcTokenFilePath,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
         "resourceGroupName": args.resourceGroupName,
+        "resources": args.resources,
         "sasToken": args.sasToken,
         "storageAccountName": args.storageAccountName,
         "subscriptionId": args.subscriptionId,
         "tenantId": args.tenantId,
+        "timeout": args.timeout,
         "useAzureadAuth": args.useAzureadAuth,
         "useMsi": args.useMsi,
         "useOidc": args.useOidc,
@@ -207,10 +265,18 @@ export interface GetAzureRMReferenceOutputArgs {
      * A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
      */
     endpoint?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
     /**
      * The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
      */
     environment?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
     /**
      * The name of the blob holding the Terraform state file inside the storage container.
      */
@@ -239,10 +305,22 @@ export interface GetAzureRMReferenceOutputArgs {
      * The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
      */
     oidcTokenFilePath?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
     /**
      * The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
      */
     resourceGroupName?: pulumi.Input<string | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
     /**
      * A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
      */
@@ -259,6 +337,10 @@ export interface GetAzureRMReferenceOutputArgs {
      * The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
      */
     tenantId?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
     /**
      * Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
      */
@@ -0,0 +1,160 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state from any backend Terraform supports, configured with the same arguments as the backend block in Terraform. Prefer the typed functions, such as getS3Reference, when one exists for the backend.
+ */
+export function getBackendReference(args: GetBackendReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetBackendReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getBackendReference", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetBackendReferenceArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: string;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: {[key: string]: any};
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: {[key: string]: any};
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetBackendReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state from any backend Terraform supports, configured with the same arguments as the backend block in Terraform. Prefer the typed functions, such as getS3Reference, when one exists for the backend.
+ */
+export function getBackendReferenceOutput(args: GetBackendReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetBackendReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getBackendReference", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetBackendReferenceOutputArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: pulumi.Input<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -0,0 +1,170 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in HCP Terraform or Terraform Enterprise, as configured by a cloud block.
+ */
+export function getCloudReference(args: GetCloudReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetCloudReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getCloudReference", {
+        "env": args.env,
+        "hostname": args.hostname,
+        "includeResources": args.includeResources,
+        "organization": args.organization,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "timeout": args.timeout,
+        "token": args.token,
+        "workspace": args.workspace,
+        "workspaces": args.workspaces,
+    }, opts);
+}
+
+export interface GetCloudReferenceArgs {
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * The hostname of HCP Terraform or Terraform Enterprise. Falls back to the TF_CLOUD_HOSTNAME environment variable, and then to app.terraform.io, when unset.
+     */
+    hostname?: string;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The name of the organization containing the targeted workspace. Falls back to the TF_CLOUD_ORGANIZATION environment variable when unset.
+     */
+    organization?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The token used to authenticate with HCP Terraform or Terraform Enterprise.
+     */
+    token?: string;
+    /**
+     * The name of the workspace to read, required when workspaces are selected by tags. Ignored when workspaces.name is set.
+     */
+    workspace?: string;
+    /**
+     * The workspaces the cloud block maps to.
+     */
+    workspaces: inputs.state.CloudWorkspaces;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetCloudReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in HCP Terraform or Terraform Enterprise, as configured by a cloud block.
+ */
+export function getCloudReferenceOutput(args: GetCloudReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCloudReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getCloudReference", {
+        "env": args.env,
+        "hostname": args.hostname,
+        "includeResources": args.includeResources,
+        "organization": args.organization,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "timeout": args.timeout,
+        "token": args.token,
+        "workspace": args.workspace,
+        "workspaces": args.workspaces,
+    }, opts);
+}
+
+export interface GetCloudReferenceOutputArgs {
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The hostname of HCP Terraform or Terraform Enterprise. Falls back to the TF_CLOUD_HOSTNAME environment variable, and then to app.terraform.io, when unset.
+     */
+    hostname?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The name of the organization containing the targeted workspace. Falls back to the TF_CLOUD_ORGANIZATION environment variable when unset.
+     */
+    organization?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The token used to authenticate with HCP Terraform or Terraform Enterprise.
+     */
+    token?: pulumi.Input<string | undefined>;
+    /**
+     * The name of the workspace to read, required when workspaces are selected by tags. Ignored when workspaces.name is set.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+    /**
+     * The workspaces the cloud block maps to.
+     */
+    workspaces: pulumi.Input<inputs.state.CloudWorkspacesArgs>;
+}
@@ -0,0 +1,220 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in the Consul KV store.
+ */
+export function getConsulReference(args: GetConsulReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetConsulReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getConsulReference", {
+        "accessToken": args.accessToken,
+        "address": args.address,
+        "caFile": args.caFile,
+        "certFile": args.certFile,
+        "datacenter": args.datacenter,
+        "env": args.env,
+        "httpAuth": args.httpAuth,
+        "includeResources": args.includeResources,
+        "keyFile": args.keyFile,
+        "outputs": args.outputs,
+        "path": args.path,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "scheme": args.scheme,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetConsulReferenceArgs {
+    /**
+     * An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+     */
+    accessToken?: string;
+    /**
+     * The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+     */
+    address?: string;
+    /**
+     * The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+     */
+    caFile?: string;
+    /**
+     * The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+     */
+    certFile?: string;
+    /**
+     * The datacenter to read from. Defaults to the datacenter of the agent.
+     */
+    datacenter?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+     */
+    httpAuth?: string;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+     */
+    keyFile?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+     */
+    path: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+     */
+    scheme?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetConsulReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in the Consul KV store.
+ */
+export function getConsulReferenceOutput(args: GetConsulReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetConsulReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getConsulReference", {
+        "accessToken": args.accessToken,
+        "address": args.address,
+        "caFile": args.caFile,
+        "certFile": args.certFile,
+        "datacenter": args.datacenter,
+        "env": args.env,
+        "httpAuth": args.httpAuth,
+        "includeResources": args.includeResources,
+        "keyFile": args.keyFile,
+        "outputs": args.outputs,
+        "path": args.path,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "scheme": args.scheme,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetConsulReferenceOutputArgs {
+    /**
+     * An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+     */
+    accessToken?: pulumi.Input<string | undefined>;
+    /**
+     * The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+     */
+    address?: pulumi.Input<string | undefined>;
+    /**
+     * The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+     */
+    caFile?: pulumi.Input<string | undefined>;
+    /**
+     * The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+     */
+    certFile?: pulumi.Input<string | undefined>;
+    /**
+     * The datacenter to read from. Defaults to the datacenter of the agent.
+     */
+    datacenter?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+     */
+    httpAuth?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+     */
+    keyFile?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+     */
+    path: pulumi.Input<string>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+     */
+    scheme?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -0,0 +1,220 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in a Tencent Cloud Object Storage (COS) bucket.
+ */
+export function getCosReference(args: GetCosReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetCosReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getCosReference", {
+        "accelerate": args.accelerate,
+        "assumeRole": args.assumeRole,
+        "bucket": args.bucket,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "key": args.key,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretId": args.secretId,
+        "secretKey": args.secretKey,
+        "securityToken": args.securityToken,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetCosReferenceArgs {
+    /**
+     * Whether to read through the global acceleration endpoint of the bucket.
+     */
+    accelerate?: boolean;
+    /**
+     * A CAM role to assume in order to read the state.
+     */
+    assumeRole?: inputs.state.CosAssumeRole;
+    /**
+     * The name of the COS bucket, in the form <name>-<appid>.
+     */
+    bucket: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+     */
+    key?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The directory in the bucket holding the state file.
+     */
+    prefix?: string;
+    /**
+     * The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+     */
+    region?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+     */
+    secretId?: string;
+    /**
+     * Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+     */
+    secretKey?: string;
+    /**
+     * The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+     */
+    securityToken?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetCosReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in a Tencent Cloud Object Storage (COS) bucket.
+ */
+export function getCosReferenceOutput(args: GetCosReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetCosReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getCosReference", {
+        "accelerate": args.accelerate,
+        "assumeRole": args.assumeRole,
+        "bucket": args.bucket,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "key": args.key,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretId": args.secretId,
+        "secretKey": args.secretKey,
+        "securityToken": args.securityToken,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetCosReferenceOutputArgs {
+    /**
+     * Whether to read through the global acceleration endpoint of the bucket.
+     */
+    accelerate?: pulumi.Input<boolean | undefined>;
+    /**
+     * A CAM role to assume in order to read the state.
+     */
+    assumeRole?: pulumi.Input<inputs.state.CosAssumeRoleArgs | undefined>;
+    /**
+     * The name of the COS bucket, in the form <name>-<appid>.
+     */
+    bucket: pulumi.Input<string>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+     */
+    key?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The directory in the bucket holding the state file.
+     */
+    prefix?: pulumi.Input<string | undefined>;
+    /**
+     * The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+     */
+    region?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+     */
+    secretId?: pulumi.Input<string | undefined>;
+    /**
+     * Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+     */
+    secretKey?: pulumi.Input<string | undefined>;
+    /**
+     * The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+     */
+    securityToken?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -0,0 +1,220 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in a Google Cloud Storage bucket.
+ */
+export function getGcsReference(args: GetGcsReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetGcsReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getGcsReference", {
+        "accessToken": args.accessToken,
+        "bucket": args.bucket,
+        "credentials": args.credentials,
+        "encryptionKey": args.encryptionKey,
+        "env": args.env,
+        "impersonateServiceAccount": args.impersonateServiceAccount,
+        "impersonateServiceAccountDelegates": args.impersonateServiceAccountDelegates,
+        "includeResources": args.includeResources,
+        "kmsEncryptionKey": args.kmsEncryptionKey,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "storageCustomEndpoint": args.storageCustomEndpoint,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetGcsReferenceArgs {
+    /**
+     * A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+     */
+    accessToken?: string;
+    /**
+     * The name of the GCS bucket.
+     */
+    bucket: string;
+    /**
+     * The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+     */
+    credentials?: string;
+    /**
+     * The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+     */
+    encryptionKey?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+     */
+    impersonateServiceAccount?: string;
+    /**
+     * The delegation chain for impersonating impersonateServiceAccount.
+     */
+    impersonateServiceAccountDelegates?: string[];
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+     */
+    kmsEncryptionKey?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+     */
+    prefix?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+     */
+    storageCustomEndpoint?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetGcsReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in a Google Cloud Storage bucket.
+ */
+export function getGcsReferenceOutput(args: GetGcsReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetGcsReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getGcsReference", {
+        "accessToken": args.accessToken,
+        "bucket": args.bucket,
+        "credentials": args.credentials,
+        "encryptionKey": args.encryptionKey,
+        "env": args.env,
+        "impersonateServiceAccount": args.impersonateServiceAccount,
+        "impersonateServiceAccountDelegates": args.impersonateServiceAccountDelegates,
+        "includeResources": args.includeResources,
+        "kmsEncryptionKey": args.kmsEncryptionKey,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "storageCustomEndpoint": args.storageCustomEndpoint,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetGcsReferenceOutputArgs {
+    /**
+     * A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+     */
+    accessToken?: pulumi.Input<string | undefined>;
+    /**
+     * The name of the GCS bucket.
+     */
+    bucket: pulumi.Input<string>;
+    /**
+     * The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+     */
+    credentials?: pulumi.Input<string | undefined>;
+    /**
+     * The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+     */
+    encryptionKey?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+     */
+    impersonateServiceAccount?: pulumi.Input<string | undefined>;
+    /**
+     * The delegation chain for impersonating impersonateServiceAccount.
+     */
+    impersonateServiceAccountDelegates?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+     */
+    kmsEncryptionKey?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+     */
+    prefix?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+     */
+    storageCustomEndpoint?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
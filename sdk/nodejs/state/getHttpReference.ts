@@ -0,0 +1,220 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state served by a REST endpoint through the http backend.
+ */
+export function getHttpReference(args: GetHttpReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetHttpReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getHttpReference", {
+        "address": args.address,
+        "clientCaCertificatePem": args.clientCaCertificatePem,
+        "clientCertificatePem": args.clientCertificatePem,
+        "clientPrivateKeyPem": args.clientPrivateKeyPem,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "password": args.password,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "retryMax": args.retryMax,
+        "retryWaitMax": args.retryWaitMax,
+        "retryWaitMin": args.retryWaitMin,
+        "skipCertVerification": args.skipCertVerification,
+        "timeout": args.timeout,
+        "username": args.username,
+    }, opts);
+}
+
+export interface GetHttpReferenceArgs {
+    /**
+     * The address of the REST endpoint serving the state.
+     */
+    address: string;
+    /**
+     * A PEM-encoded CA certificate chain used to verify the server certificate.
+     */
+    clientCaCertificatePem?: string;
+    /**
+     * A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+     */
+    clientCertificatePem?: string;
+    /**
+     * The PEM-encoded private key of clientCertificatePem.
+     */
+    clientPrivateKeyPem?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+     */
+    password?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * The number of HTTP request retries. Defaults to 2.
+     */
+    retryMax?: number;
+    /**
+     * The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+     */
+    retryWaitMax?: number;
+    /**
+     * The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+     */
+    retryWaitMin?: number;
+    /**
+     * Whether to skip verification of the server's TLS certificate.
+     */
+    skipCertVerification?: boolean;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+     */
+    username?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetHttpReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state served by a REST endpoint through the http backend.
+ */
+export function getHttpReferenceOutput(args: GetHttpReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetHttpReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getHttpReference", {
+        "address": args.address,
+        "clientCaCertificatePem": args.clientCaCertificatePem,
+        "clientCertificatePem": args.clientCertificatePem,
+        "clientPrivateKeyPem": args.clientPrivateKeyPem,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "password": args.password,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "retryMax": args.retryMax,
+        "retryWaitMax": args.retryWaitMax,
+        "retryWaitMin": args.retryWaitMin,
+        "skipCertVerification": args.skipCertVerification,
+        "timeout": args.timeout,
+        "username": args.username,
+    }, opts);
+}
+
+export interface GetHttpReferenceOutputArgs {
+    /**
+     * The address of the REST endpoint serving the state.
+     */
+    address: pulumi.Input<string>;
+    /**
+     * A PEM-encoded CA certificate chain used to verify the server certificate.
+     */
+    clientCaCertificatePem?: pulumi.Input<string | undefined>;
+    /**
+     * A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+     */
+    clientCertificatePem?: pulumi.Input<string | undefined>;
+    /**
+     * The PEM-encoded private key of clientCertificatePem.
+     */
+    clientPrivateKeyPem?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+     */
+    password?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The number of HTTP request retries. Defaults to 2.
+     */
+    retryMax?: pulumi.Input<number | undefined>;
+    /**
+     * The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+     */
+    retryWaitMax?: pulumi.Input<number | undefined>;
+    /**
+     * The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+     */
+    retryWaitMin?: pulumi.Input<number | undefined>;
+    /**
+     * Whether to skip verification of the server's TLS certificate.
+     */
+    skipCertVerification?: pulumi.Input<boolean | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+     */
+    username?: pulumi.Input<string | undefined>;
+}
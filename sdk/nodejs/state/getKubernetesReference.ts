@@ -0,0 +1,280 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in a Kubernetes secret.
+ */
+export function getKubernetesReference(args: GetKubernetesReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetKubernetesReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getKubernetesReference", {
+        "clientCertificate": args.clientCertificate,
+        "clientKey": args.clientKey,
+        "clusterCaCertificate": args.clusterCaCertificate,
+        "configContext": args.configContext,
+        "configContextAuthInfo": args.configContextAuthInfo,
+        "configContextCluster": args.configContextCluster,
+        "configPath": args.configPath,
+        "configPaths": args.configPaths,
+        "env": args.env,
+        "exec": args.exec,
+        "host": args.host,
+        "inClusterConfig": args.inClusterConfig,
+        "includeResources": args.includeResources,
+        "insecure": args.insecure,
+        "namespace": args.namespace,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretSuffix": args.secretSuffix,
+        "timeout": args.timeout,
+        "token": args.token,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetKubernetesReferenceArgs {
+    /**
+     * The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+     */
+    clientCertificate?: string;
+    /**
+     * The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+     */
+    clientKey?: string;
+    /**
+     * The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+     */
+    clusterCaCertificate?: string;
+    /**
+     * The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+     */
+    configContext?: string;
+    /**
+     * The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+     */
+    configContextAuthInfo?: string;
+    /**
+     * The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+     */
+    configContextCluster?: string;
+    /**
+     * The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+     */
+    configPath?: string;
+    /**
+     * A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+     */
+    configPaths?: string[];
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * A credential plugin used to obtain credentials for the API server.
+     */
+    exec?: inputs.state.KubernetesExec;
+    /**
+     * The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+     */
+    host?: string;
+    /**
+     * Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+     */
+    inClusterConfig?: boolean;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+     */
+    insecure?: boolean;
+    /**
+     * The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+     */
+    namespace?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+     */
+    secretSuffix: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+     */
+    token?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetKubernetesReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in a Kubernetes secret.
+ */
+export function getKubernetesReferenceOutput(args: GetKubernetesReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetKubernetesReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getKubernetesReference", {
+        "clientCertificate": args.clientCertificate,
+        "clientKey": args.clientKey,
+        "clusterCaCertificate": args.clusterCaCertificate,
+        "configContext": args.configContext,
+        "configContextAuthInfo": args.configContextAuthInfo,
+        "configContextCluster": args.configContextCluster,
+        "configPath": args.configPath,
+        "configPaths": args.configPaths,
+        "env": args.env,
+        "exec": args.exec,
+        "host": args.host,
+        "inClusterConfig": args.inClusterConfig,
+        "includeResources": args.includeResources,
+        "insecure": args.insecure,
+        "namespace": args.namespace,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretSuffix": args.secretSuffix,
+        "timeout": args.timeout,
+        "token": args.token,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetKubernetesReferenceOutputArgs {
+    /**
+     * The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+     */
+    clientCertificate?: pulumi.Input<string | undefined>;
+    /**
+     * The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+     */
+    clientKey?: pulumi.Input<string | undefined>;
+    /**
+     * The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+     */
+    clusterCaCertificate?: pulumi.Input<string | undefined>;
+    /**
+     * The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+     */
+    configContext?: pulumi.Input<string | undefined>;
+    /**
+     * The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+     */
+    configContextAuthInfo?: pulumi.Input<string | undefined>;
+    /**
+     * The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+     */
+    configContextCluster?: pulumi.Input<string | undefined>;
+    /**
+     * The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+     */
+    configPath?: pulumi.Input<string | undefined>;
+    /**
+     * A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+     */
+    configPaths?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * A credential plugin used to obtain credentials for the API server.
+     */
+    exec?: pulumi.Input<inputs.state.KubernetesExecArgs | undefined>;
+    /**
+     * The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+     */
+    host?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+     */
+    inClusterConfig?: pulumi.Input<boolean | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+     */
+    insecure?: pulumi.Input<boolean | undefined>;
+    /**
+     * The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+     */
+    namespace?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+     */
+    secretSuffix: pulumi.Input<string>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+     */
+    token?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -0,0 +1,290 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.
+ */
+export function getOssReference(args: GetOssReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetOssReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getOssReference", {
+        "accessKey": args.accessKey,
+        "assumeRolePolicy": args.assumeRolePolicy,
+        "assumeRoleSessionExpiration": args.assumeRoleSessionExpiration,
+        "bucket": args.bucket,
+        "ecsRoleName": args.ecsRoleName,
+        "endpoint": args.endpoint,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "key": args.key,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "profile": args.profile,
+        "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "roleArn": args.roleArn,
+        "secretKey": args.secretKey,
+        "securityToken": args.securityToken,
+        "sessionName": args.sessionName,
+        "sharedCredentialsFile": args.sharedCredentialsFile,
+        "stsEndpoint": args.stsEndpoint,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetOssReferenceArgs {
+    /**
+     * Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+     */
+    accessKey?: string;
+    /**
+     * A policy further restricting the permissions of the assumed role.
+     */
+    assumeRolePolicy?: string;
+    /**
+     * The duration, in seconds, of the assume role session, between 900 and 3600.
+     */
+    assumeRoleSessionExpiration?: number;
+    /**
+     * The name of the OSS bucket.
+     */
+    bucket: string;
+    /**
+     * The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+     */
+    ecsRoleName?: string;
+    /**
+     * A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+     */
+    endpoint?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+     */
+    key?: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The directory in the bucket holding the state. Defaults to env:.
+     */
+    prefix?: string;
+    /**
+     * The profile name as set in the shared credentials file.
+     */
+    profile?: string;
+    /**
+     * The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+     */
+    region?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * The ARN of a RAM role to be assumed in order to read the state.
+     */
+    roleArn?: string;
+    /**
+     * Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+     */
+    secretKey?: string;
+    /**
+     * The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+     */
+    securityToken?: string;
+    /**
+     * The session name to use when assuming the role.
+     */
+    sessionName?: string;
+    /**
+     * Path to a shared credentials file.
+     */
+    sharedCredentialsFile?: string;
+    /**
+     * A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+     */
+    stsEndpoint?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+/**
+ * The result of fetching from a Terraform state store.
+ */
+export interface GetOssReferenceResult {
+    /**
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
+}
+/**
+ * Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.
+ */
+export function getOssReferenceOutput(args: GetOssReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetOssReferenceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getOssReference", {
+        "accessKey": args.accessKey,
+        "assumeRolePolicy": args.assumeRolePolicy,
+        "assumeRoleSessionExpiration": args.assumeRoleSessionExpiration,
+        "bucket": args.bucket,
+        "ecsRoleName": args.ecsRoleName,
+        "endpoint": args.endpoint,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "key": args.key,
+        "outputs": args.outputs,
+        "prefix": args.prefix,
+        "profile": args.profile,
+        "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "roleArn": args.roleArn,
+        "secretKey": args.secretKey,
+        "securityToken": args.securityToken,
+        "sessionName": args.sessionName,
+        "sharedCredentialsFile": args.sharedCredentialsFile,
+        "stsEndpoint": args.stsEndpoint,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetOssReferenceOutputArgs {
+    /**
+     * Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+     */
+    accessKey?: pulumi.Input<string | undefined>;
+    /**
+     * A policy further restricting the permissions of the assumed role.
+     */
+    assumeRolePolicy?: pulumi.Input<string | undefined>;
+    /**
+     * The duration, in seconds, of the assume role session, between 900 and 3600.
+     */
+    assumeRoleSessionExpiration?: pulumi.Input<number | undefined>;
+    /**
+     * The name of the OSS bucket.
+     */
+    bucket: pulumi.Input<string>;
+    /**
+     * The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+     */
+    ecsRoleName?: pulumi.Input<string | undefined>;
+    /**
+     * A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+     */
+    endpoint?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+     */
+    key?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The directory in the bucket holding the state. Defaults to env:.
+     */
+    prefix?: pulumi.Input<string | undefined>;
+    /**
+     * The profile name as set in the shared credentials file.
+     */
+    profile?: pulumi.Input<string | undefined>;
+    /**
+     * The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+     */
+    region?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The ARN of a RAM role to be assumed in order to read the state.
+     */
+    roleArn?: pulumi.Input<string | undefined>;
+    /**
+     * Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+     */
+    secretKey?: pulumi.Input<string | undefined>;
+    /**
+     * The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+     */
+    securityToken?: pulumi.Input<string | undefined>;
+    /**
+     * The session name to use when assuming the role.
+     */
+    sessionName?: pulumi.Input<string | undefined>;
+    /**
+     * Path to a shared credentials file.
+     */
+    sharedCredentialsFile?: pulumi.Input<string | undefined>;
+    /**
+     * A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+     */
+    stsEndpoint?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -0,0 +1,115 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "../utilities";
+
+/**
+ * Read a single output from Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference. Outputs Terraform marks as sensitive are returned as secrets.
+ */
+export function getOutput(args: GetOutputArgs, opts?: pulumi.InvokeOptions): Promise<GetOutputResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getOutput", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "default": args.default,
+        "env": args.env,
+        "name": args.name,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetOutputArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: string;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: {[key: string]: any};
+    /**
+     * The value to return when the state has no output named name. When unset, a missing output is an error.
+     */
+    default?: any;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * The name of the output to read.
+     */
+    name: string;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: {[key: string]: any};
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: string;
+}
+
+export interface GetOutputResult {
+    /**
+     * The value of the output. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    readonly value?: any;
+}
+/**
+ * Read a single output from Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference. Outputs Terraform marks as sensitive are returned as secrets.
+ */
+export function getOutputOutput(args: GetOutputOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetOutputResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getOutput", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "default": args.default,
+        "env": args.env,
+        "name": args.name,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspace": args.workspace,
+    }, opts);
+}
+
+export interface GetOutputOutputArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: pulumi.Input<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * The value to return when the state has no output named name. When unset, a missing output is an error.
+     */
+    default?: any | undefined;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The name of the output to read.
+     */
+    name: pulumi.Input<string>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
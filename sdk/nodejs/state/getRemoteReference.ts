@@ -12,8 +12,14 @@ import * as utilities from "../utilities";
 export function getRemoteReference(args: GetRemoteReferenceArgs, opts?: pulumi.InvokeOptions): Promise<GetRemoteReferenceResult> {
     opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
     return pulumi.runtime.invoke("terraform:state:getRemoteReference", {
+        "env": args.env,
         "hostname": args.hostname,
+        "includeResources": args.includeResources,
         "organization": args.organization,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "timeout": args.timeout,
         "token": args.token,
         "workspaces": args.workspaces,
     }, opts);
@@ -21,13 +27,37 @@ export function getRemoteReference(args: GetRemoteReferenceArgs, opts?: pulumi.I
 
 export interface GetRemoteReferenceArgs {
     /**
-     * The remote backend hostname to connect to.
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * The remote backend hostname to connect to. Defaults to app.terraform.io.
      */
     hostname?: string;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
     /**
      * The name of the organization containing the targeted workspace(s).
      */
     organization: string;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
     /**
      * The token used to authenticate with the remote backend.
      */
@@ -40,9 +70,29 @@ export interface GetRemoteReferenceArgs {
  */
 export interface GetRemoteReferenceResult {
     /**
-     * The outputs displayed from Terraform state.
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
      */
     readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
 }
 /**
  * Access state from a remote backend.
@@ -50,8 +100,14 @@ export interface GetRemoteReferenceResult {
 export function getRemoteReferenceOutput(args: GetRemoteReferenceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetRemoteReferenceResult> {
     opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
     return pulumi.runtime.invokeOutput("terraform:state:getRemoteReference", {
+        "env": args.env,
         "hostname": args.hostname,
+        "includeResources": args.includeResources,
         "organization": args.organization,
+        "outputs": args.outputs,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "timeout": args.timeout,
         "token": args.token,
         "workspaces": args.workspaces,
     }, opts);
@@ -59,13 +115,37 @@ export function getRemoteReferenceOutput(args: GetRemoteReferenceOutputArgs, opt
 
 export interface GetRemoteReferenceOutputArgs {
     /**
-     * The remote backend hostname to connect to.
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The remote backend hostname to connect to. Defaults to app.terraform.io.
      */
     hostname?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
     /**
      * The name of the organization containing the targeted workspace(s).
      */
     organization: pulumi.Input<string>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
     /**
      * The token used to authenticate with the remote backend.
      */
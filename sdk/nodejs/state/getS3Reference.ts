@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
 import * as utilities from "../utilities";
 
 /**
@@ -19,14 +21,19 @@ export function getS3Reference(args: GetS3ReferenceArgs, opts?: pulumi.InvokeOpt
         "bucket": args.bucket,
         "encrypt": args.encrypt,
         "endpoint": args.endpoint,
+        "env": args.env,
         "externalId": args.externalId,
         "forcePathStyle": args.forcePathStyle,
         "iamEndpoint": args.iamEndpoint,
+        "includeResources": args.includeResources,
         "key": args.key,
         "kmsKeyId": args.kmsKeyId,
         "maxRetries": args.maxRetries,
+        "outputs": args.outputs,
         "profile": args.profile,
         "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
         "roleArn": args.roleArn,
         "secretKey": args.secretKey,
         "sessionName": args.sessionName,
@@ -36,6 +43,7 @@ export function getS3Reference(args: GetS3ReferenceArgs, opts?: pulumi.InvokeOpt
         "skipRegionValidation": args.skipRegionValidation,
         "sseCustomerKey": args.sseCustomerKey,
         "stsEndpoint": args.stsEndpoint,
+        "timeout": args.timeout,
         "token": args.token,
         "workspace": args.workspace,
         "workspaceKeyPrefix": args.workspaceKeyPrefix,
@@ -79,6 +87,10 @@ export interface GetS3ReferenceArgs {
      * A custom endpoint for the S3 API.
      */
     endpoint?: string;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
     /**
      * The external ID to use when assuming the role.
      */
@@ -91,6 +103,10 @@ export interface GetS3ReferenceArgs {
      * A custom endpoint for the IAM API.
      */
     iamEndpoint?: string;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
     /**
      * The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
      */
@@ -103,6 +119,10 @@ export interface GetS3ReferenceArgs {
      * The maximum number of times an AWS API request is retried on retryable failure.
      */
     maxRetries?: number;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
     /**
      * AWS profile name as set in the shared credentials file.
      */
@@ -111,6 +131,14 @@ export interface GetS3ReferenceArgs {
      * AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
      */
     region?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
     /**
      * The ARN of an IAM Role to be assumed in order to read the state.
      */
@@ -147,6 +175,10 @@ export interface GetS3ReferenceArgs {
      * A custom endpoint for the STS API.
      */
     stsEndpoint?: string;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
     /**
      * AWS session token.
      */
@@ -166,9 +198,29 @@ export interface GetS3ReferenceArgs {
  */
 export interface GetS3ReferenceResult {
     /**
-     * The outputs displayed from Terraform state.
+     * The lineage of the state snapshot that was read, assigned when the state was first created.
+     */
+    readonly lineage: string;
+    /**
+     * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
      */
     readonly outputs: {[key: string]: any};
+    /**
+     * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+     */
+    readonly resources?: outputs.state.StateResource[];
+    /**
+     * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+     */
+    readonly serial: number;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    readonly stateFormatVersion?: number;
+    /**
+     * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+     */
+    readonly terraformVersion?: string;
 }
 /**
  * Access state from an AWS S3 bucket.
@@ -185,14 +237,19 @@ export function getS3ReferenceOutput(args: GetS3ReferenceOutputArgs, opts?: pulu
         "bucket": args.bucket,
         "encrypt": args.encrypt,
         "endpoint": args.endpoint,
+        "env": args.env,
         "externalId": args.externalId,
         "forcePathStyle": args.forcePathStyle,
         "iamEndpoint": args.iamEndpoint,
+        "includeResources": args.includeResources,
         "key": args.key,
         "kmsKeyId": args.kmsKeyId,
         "maxRetries": args.maxRetries,
+        "outputs": args.outputs,
         "profile": args.profile,
         "region": args.region,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
         "roleArn": args.roleArn,
         "secretKey": args.secretKey,
         "sessionName": args.sessionName,
@@ -202,6 +259,7 @@ export function getS3ReferenceOutput(args: GetS3ReferenceOutputArgs, opts?: pulu
         "skipRegionValidation": args.skipRegionValidation,
         "sseCustomerKey": args.sseCustomerKey,
         "stsEndpoint": args.stsEndpoint,
+        "timeout": args.timeout,
         "token": args.token,
         "workspace": args.workspace,
         "workspaceKeyPrefix": args.workspaceKeyPrefix,
@@ -245,6 +303,10 @@ export interface GetS3ReferenceOutputArgs {
      * A custom endpoint for the S3 API.
      */
     endpoint?: pulumi.Input<string | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
     /**
      * The external ID to use when assuming the role.
      */
@@ -257,6 +319,10 @@ export interface GetS3ReferenceOutputArgs {
      * A custom endpoint for the IAM API.
      */
     iamEndpoint?: pulumi.Input<string | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
     /**
      * The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
      */
@@ -269,6 +335,10 @@ export interface GetS3ReferenceOutputArgs {
      * The maximum number of times an AWS API request is retried on retryable failure.
      */
     maxRetries?: pulumi.Input<number | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
     /**
      * AWS profile name as set in the shared credentials file.
      */
@@ -277,6 +347,14 @@ export interface GetS3ReferenceOutputArgs {
      * AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
      */
     region?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
     /**
      * The ARN of an IAM Role to be assumed in order to read the state.
      */
@@ -313,6 +391,10 @@ export interface GetS3ReferenceOutputArgs {
      * A custom endpoint for the STS API.
      */
     stsEndpoint?: pulumi.Input<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
     /**
      * AWS session token.
      */
@@ -0,0 +1,171 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "../types/input";
+import * as outputs from "../types/output";
+import * as utilities from "../utilities";
+
+/**
+ * Access the state of several workspaces of any backend Terraform supports, configured as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.
+ */
+export function getWorkspaceReferences(args: GetWorkspaceReferencesArgs, opts?: pulumi.InvokeOptions): Promise<GetWorkspaceReferencesResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:getWorkspaceReferences", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "parallelism": args.parallelism,
+        "prefix": args.prefix,
+        "regex": args.regex,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspaces": args.workspaces,
+    }, opts);
+}
+
+export interface GetWorkspaceReferencesArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: string;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: {[key: string]: any};
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: boolean;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: string[];
+    /**
+     * The most workspaces read at the same time.
+     */
+    parallelism?: number;
+    /**
+     * Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+     */
+    prefix?: string;
+    /**
+     * Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+     */
+    regex?: string;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: string[];
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: string[];
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: {[key: string]: any};
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+    /**
+     * The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+     */
+    workspaces?: string[];
+}
+
+export interface GetWorkspaceReferencesResult {
+    /**
+     * The error reading each workspace that could not be read, keyed by workspace name.
+     */
+    readonly errors: {[key: string]: string};
+    /**
+     * The state of each workspace read successfully, keyed by workspace name.
+     */
+    readonly workspaces: {[key: string]: outputs.state.StateReferenceOutputs};
+}
+/**
+ * Access the state of several workspaces of any backend Terraform supports, configured as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.
+ */
+export function getWorkspaceReferencesOutput(args: GetWorkspaceReferencesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetWorkspaceReferencesResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:getWorkspaceReferences", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "includeResources": args.includeResources,
+        "outputs": args.outputs,
+        "parallelism": args.parallelism,
+        "prefix": args.prefix,
+        "regex": args.regex,
+        "requiredOutputs": args.requiredOutputs,
+        "resources": args.resources,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+        "workspaces": args.workspaces,
+    }, opts);
+}
+
+export interface GetWorkspaceReferencesOutputArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: pulumi.Input<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+     */
+    includeResources?: pulumi.Input<boolean | undefined>;
+    /**
+     * The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+     */
+    outputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The most workspaces read at the same time.
+     */
+    parallelism?: pulumi.Input<number | undefined>;
+    /**
+     * Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+     */
+    prefix?: pulumi.Input<string | undefined>;
+    /**
+     * Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+     */
+    regex?: pulumi.Input<string | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+     */
+    resources?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+     */
+    workspaces?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+}
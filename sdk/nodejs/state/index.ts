@@ -1,6 +1,7 @@
 // *** WARNING: this file was generated by pulumi-language-nodejs. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
+import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "../utilities";
 
 // Export members:
@@ -9,11 +10,61 @@ export const getAzureRMReference: typeof import("./getAzureRMReference").getAzur
 export const getAzureRMReferenceOutput: typeof import("./getAzureRMReference").getAzureRMReferenceOutput = null as any;
 utilities.lazyLoad(exports, ["getAzureRMReference","getAzureRMReferenceOutput"], () => require("./getAzureRMReference"));
 
+export { GetBackendReferenceArgs, GetBackendReferenceResult, GetBackendReferenceOutputArgs } from "./getBackendReference";
+export const getBackendReference: typeof import("./getBackendReference").getBackendReference = null as any;
+export const getBackendReferenceOutput: typeof import("./getBackendReference").getBackendReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getBackendReference","getBackendReferenceOutput"], () => require("./getBackendReference"));
+
+export { GetCloudReferenceArgs, GetCloudReferenceResult, GetCloudReferenceOutputArgs } from "./getCloudReference";
+export const getCloudReference: typeof import("./getCloudReference").getCloudReference = null as any;
+export const getCloudReferenceOutput: typeof import("./getCloudReference").getCloudReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getCloudReference","getCloudReferenceOutput"], () => require("./getCloudReference"));
+
+export { GetConsulReferenceArgs, GetConsulReferenceResult, GetConsulReferenceOutputArgs } from "./getConsulReference";
+export const getConsulReference: typeof import("./getConsulReference").getConsulReference = null as any;
+export const getConsulReferenceOutput: typeof import("./getConsulReference").getConsulReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getConsulReference","getConsulReferenceOutput"], () => require("./getConsulReference"));
+
+export { GetCosReferenceArgs, GetCosReferenceResult, GetCosReferenceOutputArgs } from "./getCosReference";
+export const getCosReference: typeof import("./getCosReference").getCosReference = null as any;
+export const getCosReferenceOutput: typeof import("./getCosReference").getCosReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getCosReference","getCosReferenceOutput"], () => require("./getCosReference"));
+
+export { GetGcsReferenceArgs, GetGcsReferenceResult, GetGcsReferenceOutputArgs } from "./getGcsReference";
+export const getGcsReference: typeof import("./getGcsReference").getGcsReference = null as any;
+export const getGcsReferenceOutput: typeof import("./getGcsReference").getGcsReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getGcsReference","getGcsReferenceOutput"], () => require("./getGcsReference"));
+
+export { GetHttpReferenceArgs, GetHttpReferenceResult, GetHttpReferenceOutputArgs } from "./getHttpReference";
+export const getHttpReference: typeof import("./getHttpReference").getHttpReference = null as any;
+export const getHttpReferenceOutput: typeof import("./getHttpReference").getHttpReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getHttpReference","getHttpReferenceOutput"], () => require("./getHttpReference"));
+
+export { GetKubernetesReferenceArgs, GetKubernetesReferenceResult, GetKubernetesReferenceOutputArgs } from "./getKubernetesReference";
+export const getKubernetesReference: typeof import("./getKubernetesReference").getKubernetesReference = null as any;
+export const getKubernetesReferenceOutput: typeof import("./getKubernetesReference").getKubernetesReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getKubernetesReference","getKubernetesReferenceOutput"], () => require("./getKubernetesReference"));
+
 export { GetLocalReferenceArgs, GetLocalReferenceResult, GetLocalReferenceOutputArgs } from "./getLocalReference";
 export const getLocalReference: typeof import("./getLocalReference").getLocalReference = null as any;
 export const getLocalReferenceOutput: typeof import("./getLocalReference").getLocalReferenceOutput = null as any;
 utilities.lazyLoad(exports, ["getLocalReference","getLocalReferenceOutput"], () => require("./getLocalReference"));
 
+export { GetOssReferenceArgs, GetOssReferenceResult, GetOssReferenceOutputArgs } from "./getOssReference";
+export const getOssReference: typeof import("./getOssReference").getOssReference = null as any;
+export const getOssReferenceOutput: typeof import("./getOssReference").getOssReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getOssReference","getOssReferenceOutput"], () => require("./getOssReference"));
+
+export { GetOutputArgs, GetOutputResult, GetOutputOutputArgs } from "./getOutput";
+export const getOutput: typeof import("./getOutput").getOutput = null as any;
+export const getOutputOutput: typeof import("./getOutput").getOutputOutput = null as any;
+utilities.lazyLoad(exports, ["getOutput","getOutputOutput"], () => require("./getOutput"));
+
+export { GetPgReferenceArgs, GetPgReferenceResult, GetPgReferenceOutputArgs } from "./getPgReference";
+export const getPgReference: typeof import("./getPgReference").getPgReference = null as any;
+export const getPgReferenceOutput: typeof import("./getPgReference").getPgReferenceOutput = null as any;
+utilities.lazyLoad(exports, ["getPgReference","getPgReferenceOutput"], () => require("./getPgReference"));
+
 export { GetRemoteReferenceArgs, GetRemoteReferenceResult, GetRemoteReferenceOutputArgs } from "./getRemoteReference";
 export const getRemoteReference: typeof import("./getRemoteReference").getRemoteReference = null as any;
 export const getRemoteReferenceOutput: typeof import("./getRemoteReference").getRemoteReferenceOutput = null as any;
@@ -24,3 +75,31 @@ export const getS3Reference: typeof import("./getS3Reference").getS3Reference =
 export const getS3ReferenceOutput: typeof import("./getS3Reference").getS3ReferenceOutput = null as any;
 utilities.lazyLoad(exports, ["getS3Reference","getS3ReferenceOutput"], () => require("./getS3Reference"));
 
+export { GetWorkspaceReferencesArgs, GetWorkspaceReferencesResult, GetWorkspaceReferencesOutputArgs } from "./getWorkspaceReferences";
+export const getWorkspaceReferences: typeof import("./getWorkspaceReferences").getWorkspaceReferences = null as any;
+export const getWorkspaceReferencesOutput: typeof import("./getWorkspaceReferences").getWorkspaceReferencesOutput = null as any;
+utilities.lazyLoad(exports, ["getWorkspaceReferences","getWorkspaceReferencesOutput"], () => require("./getWorkspaceReferences"));
+
+export { ListWorkspacesArgs, ListWorkspacesResult, ListWorkspacesOutputArgs } from "./listWorkspaces";
+export const listWorkspaces: typeof import("./listWorkspaces").listWorkspaces = null as any;
+export const listWorkspacesOutput: typeof import("./listWorkspaces").listWorkspacesOutput = null as any;
+utilities.lazyLoad(exports, ["listWorkspaces","listWorkspacesOutput"], () => require("./listWorkspaces"));
+
+export { ReferenceArgs } from "./reference";
+export type Reference = import("./reference").Reference;
+export const Reference: typeof import("./reference").Reference = null as any;
+utilities.lazyLoad(exports, ["Reference"], () => require("./reference"));
+
+
+const _module = {
+    version: utilities.getVersion(),
+    construct: (name: string, type: string, urn: string): pulumi.Resource => {
+        switch (type) {
+            case "terraform:state:Reference":
+                return new Reference(name, <any>undefined, { urn })
+            default:
+                throw new Error(`unknown resource type ${type}`);
+        }
+    },
+};
+pulumi.runtime.registerResourceModule("terraform", "state", _module)
@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "../utilities";
+
+/**
+ * List the workspaces of any backend Terraform supports, configured with the same arguments as the backend block in Terraform.
+ */
+export function listWorkspaces(args: ListWorkspacesArgs, opts?: pulumi.InvokeOptions): Promise<ListWorkspacesResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("terraform:state:listWorkspaces", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "prefix": args.prefix,
+        "regex": args.regex,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+    }, opts);
+}
+
+export interface ListWorkspacesArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: string;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: {[key: string]: any};
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: {[key: string]: string};
+    /**
+     * Only list the workspaces whose name starts with this prefix.
+     */
+    prefix?: string;
+    /**
+     * Only list the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names.
+     */
+    regex?: string;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: {[key: string]: any};
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: string;
+}
+
+export interface ListWorkspacesResult {
+    /**
+     * The names of the workspaces, sorted. They can be passed as the workspace argument of the state reference functions.
+     */
+    readonly workspaces: string[];
+}
+/**
+ * List the workspaces of any backend Terraform supports, configured with the same arguments as the backend block in Terraform.
+ */
+export function listWorkspacesOutput(args: ListWorkspacesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<ListWorkspacesResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("terraform:state:listWorkspaces", {
+        "backendType": args.backendType,
+        "config": args.config,
+        "env": args.env,
+        "prefix": args.prefix,
+        "regex": args.regex,
+        "secretConfig": args.secretConfig,
+        "timeout": args.timeout,
+    }, opts);
+}
+
+export interface ListWorkspacesOutputArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: pulumi.Input<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * Only list the workspaces whose name starts with this prefix.
+     */
+    prefix?: pulumi.Input<string | undefined>;
+    /**
+     * Only list the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names.
+     */
+    regex?: pulumi.Input<string | undefined>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+}
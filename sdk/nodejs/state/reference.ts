@@ -0,0 +1,167 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "../utilities";
+
+/**
+ * A snapshot of Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference.
+ *
+ * The outputs and the serial of the snapshot are kept in the Pulumi state. When the upstream Terraform state changes, the next preview shows an update of serial, and dependents see the new outputs once it is applied.
+ */
+export class Reference extends pulumi.CustomResource {
+    /**
+     * Get an existing Reference resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Reference {
+        return new Reference(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'terraform:state:Reference';
+
+    /**
+     * Returns true if the given object is an instance of Reference.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Reference {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Reference.__pulumiType;
+    }
+
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    declare public readonly backendType: pulumi.Output<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    declare public readonly config: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    declare public readonly env: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The lineage of the snapshot, assigned when the state was first created.
+     */
+    declare public /*out*/ readonly lineage: pulumi.Output<string>;
+    /**
+     * The outputs of the snapshot. Outputs Terraform marks as sensitive are secrets. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+     */
+    declare public /*out*/ readonly outputs: pulumi.Output<{[key: string]: any}>;
+    /**
+     * The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+     */
+    declare public readonly requiredOutputs: pulumi.Output<string[] | undefined>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    declare public readonly secretConfig: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * The serial of the snapshot. Terraform increments it on every change to the state.
+     */
+    declare public /*out*/ readonly serial: pulumi.Output<number>;
+    /**
+     * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+     */
+    declare public /*out*/ readonly stateFormatVersion: pulumi.Output<number | undefined>;
+    /**
+     * The version of Terraform that wrote the snapshot. Unset when the backend does not record it.
+     */
+    declare public /*out*/ readonly terraformVersion: pulumi.Output<string | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    declare public readonly timeout: pulumi.Output<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    declare public readonly workspace: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a Reference resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: ReferenceArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.backendType === undefined && !opts.urn) {
+                throw new Error("Missing required property 'backendType'");
+            }
+            resourceInputs["backendType"] = args?.backendType;
+            resourceInputs["config"] = args?.config;
+            resourceInputs["env"] = args?.env ? pulumi.secret(args.env) : undefined;
+            resourceInputs["requiredOutputs"] = args?.requiredOutputs;
+            resourceInputs["secretConfig"] = args?.secretConfig ? pulumi.secret(args.secretConfig) : undefined;
+            resourceInputs["timeout"] = args?.timeout;
+            resourceInputs["workspace"] = (args?.workspace) ?? "default";
+            resourceInputs["lineage"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["serial"] = undefined /*out*/;
+            resourceInputs["stateFormatVersion"] = undefined /*out*/;
+            resourceInputs["terraformVersion"] = undefined /*out*/;
+        } else {
+            resourceInputs["backendType"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["env"] = undefined /*out*/;
+            resourceInputs["lineage"] = undefined /*out*/;
+            resourceInputs["outputs"] = undefined /*out*/;
+            resourceInputs["requiredOutputs"] = undefined /*out*/;
+            resourceInputs["secretConfig"] = undefined /*out*/;
+            resourceInputs["serial"] = undefined /*out*/;
+            resourceInputs["stateFormatVersion"] = undefined /*out*/;
+            resourceInputs["terraformVersion"] = undefined /*out*/;
+            resourceInputs["timeout"] = undefined /*out*/;
+            resourceInputs["workspace"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["env", "secretConfig"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Reference.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Reference resource.
+ */
+export interface ReferenceArgs {
+    /**
+     * The type of the backend, as in the label of the backend block, e.g. s3.
+     */
+    backendType: pulumi.Input<string>;
+    /**
+     * The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+     */
+    config?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    /**
+     * The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+     */
+    requiredOutputs?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    /**
+     * Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+     */
+    secretConfig?: pulumi.Input<{[key: string]: any} | undefined>;
+    /**
+     * How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+     */
+    timeout?: pulumi.Input<string | undefined>;
+    /**
+     * The Terraform workspace to read state from.
+     */
+    workspace?: pulumi.Input<string | undefined>;
+}
@@ -6,6 +6,284 @@ import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
 export namespace state {
+    export interface AzureRMDefaultsArgs {
+        /**
+         * The password of the default client certificate.
+         */
+        clientCertificatePassword?: pulumi.Input<string | undefined>;
+        /**
+         * The default path to the PFX file used as the client certificate.
+         */
+        clientCertificatePath?: pulumi.Input<string | undefined>;
+        /**
+         * The default client ID to authenticate as.
+         */
+        clientId?: pulumi.Input<string | undefined>;
+        /**
+         * The default client secret used for service principal authentication.
+         */
+        clientSecret?: pulumi.Input<string | undefined>;
+        /**
+         * The default custom endpoint for the Azure Resource Manager API.
+         */
+        endpoint?: pulumi.Input<string | undefined>;
+        /**
+         * The default Azure cloud environment.
+         */
+        environment?: pulumi.Input<string | undefined>;
+        /**
+         * The default hostname of the Azure metadata service.
+         */
+        metadataHost?: pulumi.Input<string | undefined>;
+        /**
+         * The default endpoint of the Managed Service Identity.
+         */
+        msiEndpoint?: pulumi.Input<string | undefined>;
+        /**
+         * The default bearer token for requests to oidcRequestUrl.
+         */
+        oidcRequestToken?: pulumi.Input<string | undefined>;
+        /**
+         * The default URL of the OIDC provider to request an ID token from.
+         */
+        oidcRequestUrl?: pulumi.Input<string | undefined>;
+        /**
+         * The default JWT token for OIDC authentication.
+         */
+        oidcToken?: pulumi.Input<string | undefined>;
+        /**
+         * The default path to a file containing a JWT token for OIDC authentication.
+         */
+        oidcTokenFilePath?: pulumi.Input<string | undefined>;
+        /**
+         * The default subscription ID holding the storage account.
+         */
+        subscriptionId?: pulumi.Input<string | undefined>;
+        /**
+         * The default tenant ID to authenticate against.
+         */
+        tenantId?: pulumi.Input<string | undefined>;
+        /**
+         * Whether to authenticate against the storage container with AzureAD by default.
+         */
+        useAzureadAuth?: pulumi.Input<boolean | undefined>;
+        /**
+         * Whether to authenticate using Managed Service Identity by default.
+         */
+        useMsi?: pulumi.Input<boolean | undefined>;
+        /**
+         * Whether to authenticate using OIDC by default.
+         */
+        useOidc?: pulumi.Input<boolean | undefined>;
+    }
+
+    export interface CloudWorkspaces {
+        /**
+         * The name of a single workspace. This option conflicts with tags.
+         */
+        name?: string;
+        /**
+         * The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+         */
+        tags?: string[];
+    }
+
+    export interface CloudWorkspacesArgs {
+        /**
+         * The name of a single workspace. This option conflicts with tags.
+         */
+        name?: pulumi.Input<string | undefined>;
+        /**
+         * The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+         */
+        tags?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+    }
+
+    export interface CosAssumeRole {
+        /**
+         * A policy further restricting the permissions of the assumed role.
+         */
+        policy?: string;
+        /**
+         * The ARN of the role to assume.
+         */
+        roleArn: string;
+        /**
+         * The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+         */
+        sessionDuration?: number;
+        /**
+         * The session name to use when assuming the role.
+         */
+        sessionName: string;
+    }
+
+    export interface CosAssumeRoleArgs {
+        /**
+         * A policy further restricting the permissions of the assumed role.
+         */
+        policy?: pulumi.Input<string | undefined>;
+        /**
+         * The ARN of the role to assume.
+         */
+        roleArn: pulumi.Input<string>;
+        /**
+         * The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+         */
+        sessionDuration?: pulumi.Input<number | undefined>;
+        /**
+         * The session name to use when assuming the role.
+         */
+        sessionName: pulumi.Input<string>;
+    }
+
+    export interface GcsDefaultsArgs {
+        /**
+         * The default temporary OAuth 2.0 access token.
+         */
+        accessToken?: pulumi.Input<string | undefined>;
+        /**
+         * The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+         */
+        credentials?: pulumi.Input<string | undefined>;
+        /**
+         * The service account to impersonate by default.
+         */
+        impersonateServiceAccount?: pulumi.Input<string | undefined>;
+        /**
+         * The default delegation chain for impersonating impersonateServiceAccount.
+         */
+        impersonateServiceAccountDelegates?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+        /**
+         * The default custom endpoint for the Cloud Storage API.
+         */
+        storageCustomEndpoint?: pulumi.Input<string | undefined>;
+    }
+
+    export interface KubernetesExec {
+        /**
+         * The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+         */
+        apiVersion: string;
+        /**
+         * The arguments passed to the command.
+         */
+        args?: string[];
+        /**
+         * The command to run.
+         */
+        command: string;
+        /**
+         * Additional environment variables for the command.
+         */
+        env?: {[key: string]: string};
+    }
+
+    export interface KubernetesExecArgs {
+        /**
+         * The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+         */
+        apiVersion: pulumi.Input<string>;
+        /**
+         * The arguments passed to the command.
+         */
+        args?: pulumi.Input<pulumi.Input<string>[] | undefined>;
+        /**
+         * The command to run.
+         */
+        command: pulumi.Input<string>;
+        /**
+         * Additional environment variables for the command.
+         */
+        env?: pulumi.Input<{[key: string]: pulumi.Input<string>} | undefined>;
+    }
+
+    export interface RemoteDefaultsArgs {
+        /**
+         * The default hostname to connect to.
+         */
+        hostname?: pulumi.Input<string | undefined>;
+        /**
+         * The default token used to authenticate with the backend.
+         */
+        token?: pulumi.Input<string | undefined>;
+    }
+
+    export interface S3DefaultsArgs {
+        /**
+         * The default AWS access key.
+         */
+        accessKey?: pulumi.Input<string | undefined>;
+        /**
+         * The default duration, in seconds, of the assume role session.
+         */
+        assumeRoleDurationSeconds?: pulumi.Input<number | undefined>;
+        /**
+         * The default custom endpoint for the S3 API.
+         */
+        endpoint?: pulumi.Input<string | undefined>;
+        /**
+         * The default external ID to use when assuming the role.
+         */
+        externalId?: pulumi.Input<string | undefined>;
+        /**
+         * Whether to force path-style addressing by default.
+         */
+        forcePathStyle?: pulumi.Input<boolean | undefined>;
+        /**
+         * The default custom endpoint for the IAM API.
+         */
+        iamEndpoint?: pulumi.Input<string | undefined>;
+        /**
+         * The default maximum number of times an AWS API request is retried.
+         */
+        maxRetries?: pulumi.Input<number | undefined>;
+        /**
+         * The default AWS profile name.
+         */
+        profile?: pulumi.Input<string | undefined>;
+        /**
+         * The default AWS region of the S3 bucket.
+         */
+        region?: pulumi.Input<string | undefined>;
+        /**
+         * The ARN of the IAM Role assumed by default in order to read the state.
+         */
+        roleArn?: pulumi.Input<string | undefined>;
+        /**
+         * The default AWS secret key.
+         */
+        secretKey?: pulumi.Input<string | undefined>;
+        /**
+         * The default session name to use when assuming the role.
+         */
+        sessionName?: pulumi.Input<string | undefined>;
+        /**
+         * The default path to a shared credentials file.
+         */
+        sharedCredentialsFile?: pulumi.Input<string | undefined>;
+        /**
+         * Whether to skip the credentials validation by default.
+         */
+        skipCredentialsValidation?: pulumi.Input<boolean | undefined>;
+        /**
+         * Whether to skip the AWS Metadata API check by default.
+         */
+        skipMetadataApiCheck?: pulumi.Input<boolean | undefined>;
+        /**
+         * Whether to skip static validation of the region name by default.
+         */
+        skipRegionValidation?: pulumi.Input<boolean | undefined>;
+        /**
+         * The default custom endpoint for the STS API.
+         */
+        stsEndpoint?: pulumi.Input<string | undefined>;
+        /**
+         * The default AWS session token.
+         */
+        token?: pulumi.Input<string | undefined>;
+    }
+
     export interface Workspaces {
         /**
          * The full name of one remote workspace. When configured, only the default workspace can be used. This option conflicts with prefix.
@@ -6,4 +6,249 @@ import * as inputs from "../types/input";
 import * as outputs from "../types/output";
 
 export namespace state {
+    export interface AzureRMDefaults {
+        /**
+         * The password of the default client certificate.
+         */
+        clientCertificatePassword?: string;
+        /**
+         * The default path to the PFX file used as the client certificate.
+         */
+        clientCertificatePath?: string;
+        /**
+         * The default client ID to authenticate as.
+         */
+        clientId?: string;
+        /**
+         * The default client secret used for service principal authentication.
+         */
+        clientSecret?: string;
+        /**
+         * The default custom endpoint for the Azure Resource Manager API.
+         */
+        endpoint?: string;
+        /**
+         * The default Azure cloud environment.
+         */
+        environment?: string;
+        /**
+         * The default hostname of the Azure metadata service.
+         */
+        metadataHost?: string;
+        /**
+         * The default endpoint of the Managed Service Identity.
+         */
+        msiEndpoint?: string;
+        /**
+         * The default bearer token for requests to oidcRequestUrl.
+         */
+        oidcRequestToken?: string;
+        /**
+         * The default URL of the OIDC provider to request an ID token from.
+         */
+        oidcRequestUrl?: string;
+        /**
+         * The default JWT token for OIDC authentication.
+         */
+        oidcToken?: string;
+        /**
+         * The default path to a file containing a JWT token for OIDC authentication.
+         */
+        oidcTokenFilePath?: string;
+        /**
+         * The default subscription ID holding the storage account.
+         */
+        subscriptionId?: string;
+        /**
+         * The default tenant ID to authenticate against.
+         */
+        tenantId?: string;
+        /**
+         * Whether to authenticate against the storage container with AzureAD by default.
+         */
+        useAzureadAuth?: boolean;
+        /**
+         * Whether to authenticate using Managed Service Identity by default.
+         */
+        useMsi?: boolean;
+        /**
+         * Whether to authenticate using OIDC by default.
+         */
+        useOidc?: boolean;
+    }
+
+    export interface GcsDefaults {
+        /**
+         * The default temporary OAuth 2.0 access token.
+         */
+        accessToken?: string;
+        /**
+         * The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+         */
+        credentials?: string;
+        /**
+         * The service account to impersonate by default.
+         */
+        impersonateServiceAccount?: string;
+        /**
+         * The default delegation chain for impersonating impersonateServiceAccount.
+         */
+        impersonateServiceAccountDelegates?: string[];
+        /**
+         * The default custom endpoint for the Cloud Storage API.
+         */
+        storageCustomEndpoint?: string;
+    }
+
+    export interface RemoteDefaults {
+        /**
+         * The default hostname to connect to.
+         */
+        hostname?: string;
+        /**
+         * The default token used to authenticate with the backend.
+         */
+        token?: string;
+    }
+
+    export interface S3Defaults {
+        /**
+         * The default AWS access key.
+         */
+        accessKey?: string;
+        /**
+         * The default duration, in seconds, of the assume role session.
+         */
+        assumeRoleDurationSeconds?: number;
+        /**
+         * The default custom endpoint for the S3 API.
+         */
+        endpoint?: string;
+        /**
+         * The default external ID to use when assuming the role.
+         */
+        externalId?: string;
+        /**
+         * Whether to force path-style addressing by default.
+         */
+        forcePathStyle?: boolean;
+        /**
+         * The default custom endpoint for the IAM API.
+         */
+        iamEndpoint?: string;
+        /**
+         * The default maximum number of times an AWS API request is retried.
+         */
+        maxRetries?: number;
+        /**
+         * The default AWS profile name.
+         */
+        profile?: string;
+        /**
+         * The default AWS region of the S3 bucket.
+         */
+        region?: string;
+        /**
+         * The ARN of the IAM Role assumed by default in order to read the state.
+         */
+        roleArn?: string;
+        /**
+         * The default AWS secret key.
+         */
+        secretKey?: string;
+        /**
+         * The default session name to use when assuming the role.
+         */
+        sessionName?: string;
+        /**
+         * The default path to a shared credentials file.
+         */
+        sharedCredentialsFile?: string;
+        /**
+         * Whether to skip the credentials validation by default.
+         */
+        skipCredentialsValidation?: boolean;
+        /**
+         * Whether to skip the AWS Metadata API check by default.
+         */
+        skipMetadataApiCheck?: boolean;
+        /**
+         * Whether to skip static validation of the region name by default.
+         */
+        skipRegionValidation?: boolean;
+        /**
+         * The default custom endpoint for the STS API.
+         */
+        stsEndpoint?: string;
+        /**
+         * The default AWS session token.
+         */
+        token?: string;
+    }
+
+    /**
+     * The result of fetching from a Terraform state store.
+     */
+    export interface StateReferenceOutputs {
+        /**
+         * The lineage of the state snapshot that was read, assigned when the state was first created.
+         */
+        lineage: string;
+        /**
+         * The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+         */
+        outputs: {[key: string]: any};
+        /**
+         * The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+         */
+        resources?: outputs.state.StateResource[];
+        /**
+         * The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+         */
+        serial: number;
+        /**
+         * The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+         */
+        stateFormatVersion?: number;
+        /**
+         * The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+         */
+        terraformVersion?: string;
+    }
+
+    export interface StateResource {
+        /**
+         * The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+         */
+        address: string;
+        /**
+         * The attributes of the instance, as recorded in state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+         */
+        attributes: {[key: string]: any};
+        /**
+         * The count index or for_each key of the instance. Unset when the resource uses neither count nor for_each.
+         */
+        indexKey?: any;
+        /**
+         * managed for resources and data for data sources.
+         */
+        mode: string;
+        /**
+         * The address of the module instance holding the resource. Empty for the root module.
+         */
+        module: string;
+        /**
+         * The name of the resource in its module, e.g. private.
+         */
+        name: string;
+        /**
+         * The provider configuration that last managed the resource, e.g. provider["registry.terraform.io/hashicorp/aws"].
+         */
+        provider: string;
+        /**
+         * The resource type, e.g. aws_subnet.
+         */
+        type: string;
+    }
+
 }
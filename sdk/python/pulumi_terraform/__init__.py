@@ -10,14 +10,26 @@ from .provider import *
 
 # Make subpackages available:
 if typing.TYPE_CHECKING:
+    import pulumi_terraform.config as __config
+    config = __config
     import pulumi_terraform.state as __state
     state = __state
 else:
+    config = _utilities.lazy_import('pulumi_terraform.config')
     state = _utilities.lazy_import('pulumi_terraform.state')
 
 _utilities.register(
     resource_modules="""
-[]
+[
+ {
+  "pkg": "terraform",
+  "mod": "state",
+  "fqn": "pulumi_terraform.state",
+  "classes": {
+   "terraform:state:Reference": "Reference"
+  }
+ }
+]
 """,
     resource_packages="""
 [
@@ -0,0 +1,62 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from .. import state as _state
+
+azurermDefaults: Optional[str]
+"""
+Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+"""
+
+cacheStateReads: Optional[bool]
+"""
+Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+"""
+
+cacheTtl: str
+"""
+How long a cached state is reused, as a duration such as 30s or 5m.
+"""
+
+disableEnvFallback: Optional[bool]
+"""
+Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+"""
+
+gcsDefaults: Optional[str]
+"""
+Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+"""
+
+remoteDefaults: Optional[str]
+"""
+Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+"""
+
+s3Defaults: Optional[str]
+"""
+Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+"""
+
+showTerraformLogs: bool
+"""
+Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+"""
+
+timeout: Optional[str]
+"""
+The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+"""
+
@@ -0,0 +1,86 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from .. import state as _state
+
+import types
+
+__config__ = pulumi.Config('terraform')
+
+
+class _ExportableConfig(types.ModuleType):
+    @_builtins.property
+    def azurerm_defaults(self) -> Optional[str]:
+        """
+        Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return __config__.get('azurermDefaults')
+
+    @_builtins.property
+    def cache_state_reads(self) -> Optional[bool]:
+        """
+        Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+        """
+        return __config__.get_bool('cacheStateReads')
+
+    @_builtins.property
+    def cache_ttl(self) -> str:
+        """
+        How long a cached state is reused, as a duration such as 30s or 5m.
+        """
+        return __config__.get('cacheTtl') or '5m'
+
+    @_builtins.property
+    def disable_env_fallback(self) -> Optional[bool]:
+        """
+        Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+        """
+        return __config__.get_bool('disableEnvFallback')
+
+    @_builtins.property
+    def gcs_defaults(self) -> Optional[str]:
+        """
+        Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return __config__.get('gcsDefaults')
+
+    @_builtins.property
+    def remote_defaults(self) -> Optional[str]:
+        """
+        Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+        """
+        return __config__.get('remoteDefaults')
+
+    @_builtins.property
+    def s3_defaults(self) -> Optional[str]:
+        """
+        Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return __config__.get('s3Defaults')
+
+    @_builtins.property
+    def show_terraform_logs(self) -> bool:
+        """
+        Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+        """
+        return __config__.get_bool('showTerraformLogs') or True
+
+    @_builtins.property
+    def timeout(self) -> Optional[str]:
+        """
+        The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+        """
+        return __config__.get('timeout')
+
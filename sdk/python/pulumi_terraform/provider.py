@@ -13,16 +13,165 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from . import _utilities
+from . import state as _state
 
 __all__ = ['ProviderArgs', 'Provider']
 
 @pulumi.input_type
 class ProviderArgs:
-    def __init__(__self__):
+    def __init__(__self__, *,
+                 azurerm_defaults: pulumi.Input[Optional['_state.AzureRMDefaultsArgs']] = None,
+                 cache_state_reads: pulumi.Input[Optional[_builtins.bool]] = None,
+                 cache_ttl: pulumi.Input[Optional[_builtins.str]] = None,
+                 disable_env_fallback: pulumi.Input[Optional[_builtins.bool]] = None,
+                 gcs_defaults: pulumi.Input[Optional['_state.GcsDefaultsArgs']] = None,
+                 remote_defaults: pulumi.Input[Optional['_state.RemoteDefaultsArgs']] = None,
+                 s3_defaults: pulumi.Input[Optional['_state.S3DefaultsArgs']] = None,
+                 show_terraform_logs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None):
         """
         The set of arguments for constructing a Provider resource.
+
+        :param pulumi.Input['_state.AzureRMDefaultsArgs'] azurerm_defaults: Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input[_builtins.bool] cache_state_reads: Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+        :param pulumi.Input[_builtins.str] cache_ttl: How long a cached state is reused, as a duration such as 30s or 5m.
+        :param pulumi.Input[_builtins.bool] disable_env_fallback: Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+        :param pulumi.Input['_state.GcsDefaultsArgs'] gcs_defaults: Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input['_state.RemoteDefaultsArgs'] remote_defaults: Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+        :param pulumi.Input['_state.S3DefaultsArgs'] s3_defaults: Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input[_builtins.bool] show_terraform_logs: Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+        :param pulumi.Input[_builtins.str] timeout: The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+        """
+        if azurerm_defaults is not None:
+            pulumi.set(__self__, "azurerm_defaults", azurerm_defaults)
+        if cache_state_reads is not None:
+            pulumi.set(__self__, "cache_state_reads", cache_state_reads)
+        if cache_ttl is None:
+            cache_ttl = '5m'
+        if cache_ttl is not None:
+            pulumi.set(__self__, "cache_ttl", cache_ttl)
+        if disable_env_fallback is not None:
+            pulumi.set(__self__, "disable_env_fallback", disable_env_fallback)
+        if gcs_defaults is not None:
+            pulumi.set(__self__, "gcs_defaults", gcs_defaults)
+        if remote_defaults is not None:
+            pulumi.set(__self__, "remote_defaults", remote_defaults)
+        if s3_defaults is not None:
+            pulumi.set(__self__, "s3_defaults", s3_defaults)
+        if show_terraform_logs is None:
+            show_terraform_logs = True
+        if show_terraform_logs is not None:
+            pulumi.set(__self__, "show_terraform_logs", show_terraform_logs)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @_builtins.property
+    @pulumi.getter(name="azurermDefaults")
+    def azurerm_defaults(self) -> pulumi.Input[Optional['_state.AzureRMDefaultsArgs']]:
+        """
+        Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return pulumi.get(self, "azurerm_defaults")
+
+    @azurerm_defaults.setter
+    def azurerm_defaults(self, value: pulumi.Input[Optional['_state.AzureRMDefaultsArgs']]):
+        pulumi.set(self, "azurerm_defaults", value)
+
+    @_builtins.property
+    @pulumi.getter(name="cacheStateReads")
+    def cache_state_reads(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+        """
+        return pulumi.get(self, "cache_state_reads")
+
+    @cache_state_reads.setter
+    def cache_state_reads(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "cache_state_reads", value)
+
+    @_builtins.property
+    @pulumi.getter(name="cacheTtl")
+    def cache_ttl(self) -> pulumi.Input[Optional[_builtins.str]]:
         """
-        pass
+        How long a cached state is reused, as a duration such as 30s or 5m.
+        """
+        return pulumi.get(self, "cache_ttl")
+
+    @cache_ttl.setter
+    def cache_ttl(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "cache_ttl", value)
+
+    @_builtins.property
+    @pulumi.getter(name="disableEnvFallback")
+    def disable_env_fallback(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+        """
+        return pulumi.get(self, "disable_env_fallback")
+
+    @disable_env_fallback.setter
+    def disable_env_fallback(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "disable_env_fallback", value)
+
+    @_builtins.property
+    @pulumi.getter(name="gcsDefaults")
+    def gcs_defaults(self) -> pulumi.Input[Optional['_state.GcsDefaultsArgs']]:
+        """
+        Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return pulumi.get(self, "gcs_defaults")
+
+    @gcs_defaults.setter
+    def gcs_defaults(self, value: pulumi.Input[Optional['_state.GcsDefaultsArgs']]):
+        pulumi.set(self, "gcs_defaults", value)
+
+    @_builtins.property
+    @pulumi.getter(name="remoteDefaults")
+    def remote_defaults(self) -> pulumi.Input[Optional['_state.RemoteDefaultsArgs']]:
+        """
+        Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+        """
+        return pulumi.get(self, "remote_defaults")
+
+    @remote_defaults.setter
+    def remote_defaults(self, value: pulumi.Input[Optional['_state.RemoteDefaultsArgs']]):
+        pulumi.set(self, "remote_defaults", value)
+
+    @_builtins.property
+    @pulumi.getter(name="s3Defaults")
+    def s3_defaults(self) -> pulumi.Input[Optional['_state.S3DefaultsArgs']]:
+        """
+        Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        """
+        return pulumi.get(self, "s3_defaults")
+
+    @s3_defaults.setter
+    def s3_defaults(self, value: pulumi.Input[Optional['_state.S3DefaultsArgs']]):
+        pulumi.set(self, "s3_defaults", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showTerraformLogs")
+    def show_terraform_logs(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+        """
+        return pulumi.get(self, "show_terraform_logs")
+
+    @show_terraform_logs.setter
+    def show_terraform_logs(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "show_terraform_logs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "timeout", value)
 
 
 @pulumi.type_token("pulumi:providers:terraform")
@@ -31,12 +180,30 @@ class Provider(pulumi.ProviderResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 azurerm_defaults: pulumi.Input[Optional[Union['_state.AzureRMDefaultsArgs', '_state.AzureRMDefaultsArgsDict']]] = None,
+                 cache_state_reads: pulumi.Input[Optional[_builtins.bool]] = None,
+                 cache_ttl: pulumi.Input[Optional[_builtins.str]] = None,
+                 disable_env_fallback: pulumi.Input[Optional[_builtins.bool]] = None,
+                 gcs_defaults: pulumi.Input[Optional[Union['_state.GcsDefaultsArgs', '_state.GcsDefaultsArgsDict']]] = None,
+                 remote_defaults: pulumi.Input[Optional[Union['_state.RemoteDefaultsArgs', '_state.RemoteDefaultsArgsDict']]] = None,
+                 s3_defaults: pulumi.Input[Optional[Union['_state.S3DefaultsArgs', '_state.S3DefaultsArgsDict']]] = None,
+                 show_terraform_logs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None,
                  __props__=None):
         """
         Create a Terraform resource with the given unique name, props, and options.
 
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Union['_state.AzureRMDefaultsArgs', '_state.AzureRMDefaultsArgsDict']] azurerm_defaults: Fallbacks for the arguments of every read from the azurerm backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input[_builtins.bool] cache_state_reads: Whether to remember the state read by functions and resources, so reading the same workspace with the same backend configuration again within cacheTtl does not fetch it again. Concurrent identical reads share a single fetch either way when enabled.
+        :param pulumi.Input[_builtins.str] cache_ttl: How long a cached state is reused, as a duration such as 30s or 5m.
+        :param pulumi.Input[_builtins.bool] disable_env_fallback: Whether to hide the environment variables of the provider process that backends fall back to for settings left unset, such as AWS_REGION for s3 or ARM_CLIENT_ID for azurerm, so reads only depend on their arguments and the env argument of functions.
+        :param pulumi.Input[Union['_state.GcsDefaultsArgs', '_state.GcsDefaultsArgsDict']] gcs_defaults: Fallbacks for the arguments of every read from the gcs backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input[Union['_state.RemoteDefaultsArgs', '_state.RemoteDefaultsArgsDict']] remote_defaults: Fallbacks for the arguments of every read from the remote and cloud backends. Arguments set on a function take precedence.
+        :param pulumi.Input[Union['_state.S3DefaultsArgs', '_state.S3DefaultsArgsDict']] s3_defaults: Fallbacks for the arguments of every read from the s3 backend. Arguments set on a function take precedence, and environment variables are only consulted when neither is set.
+        :param pulumi.Input[_builtins.bool] show_terraform_logs: Whether the log messages of Terraform's backends are shown at their own level, so warnings and errors reach the Pulumi CLI. When false, they are only logged at debug level. Which messages are forwarded at all is controlled by the TF_LOG environment variable, and defaults to warnings and errors.
+        :param pulumi.Input[_builtins.str] timeout: The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
         """
         ...
     @overload
@@ -62,6 +229,15 @@ class Provider(pulumi.ProviderResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 azurerm_defaults: pulumi.Input[Optional[Union['_state.AzureRMDefaultsArgs', '_state.AzureRMDefaultsArgsDict']]] = None,
+                 cache_state_reads: pulumi.Input[Optional[_builtins.bool]] = None,
+                 cache_ttl: pulumi.Input[Optional[_builtins.str]] = None,
+                 disable_env_fallback: pulumi.Input[Optional[_builtins.bool]] = None,
+                 gcs_defaults: pulumi.Input[Optional[Union['_state.GcsDefaultsArgs', '_state.GcsDefaultsArgsDict']]] = None,
+                 remote_defaults: pulumi.Input[Optional[Union['_state.RemoteDefaultsArgs', '_state.RemoteDefaultsArgsDict']]] = None,
+                 s3_defaults: pulumi.Input[Optional[Union['_state.S3DefaultsArgs', '_state.S3DefaultsArgsDict']]] = None,
+                 show_terraform_logs: pulumi.Input[Optional[_builtins.bool]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -71,9 +247,38 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
+            __props__.__dict__["azurerm_defaults"] = pulumi.Output.from_input(azurerm_defaults).apply(pulumi.runtime.to_json) if azurerm_defaults is not None else None
+            __props__.__dict__["cache_state_reads"] = pulumi.Output.from_input(cache_state_reads).apply(pulumi.runtime.to_json) if cache_state_reads is not None else None
+            if cache_ttl is None:
+                cache_ttl = '5m'
+            __props__.__dict__["cache_ttl"] = cache_ttl
+            __props__.__dict__["disable_env_fallback"] = pulumi.Output.from_input(disable_env_fallback).apply(pulumi.runtime.to_json) if disable_env_fallback is not None else None
+            __props__.__dict__["gcs_defaults"] = pulumi.Output.from_input(gcs_defaults).apply(pulumi.runtime.to_json) if gcs_defaults is not None else None
+            __props__.__dict__["remote_defaults"] = pulumi.Output.from_input(remote_defaults).apply(pulumi.runtime.to_json) if remote_defaults is not None else None
+            __props__.__dict__["s3_defaults"] = pulumi.Output.from_input(s3_defaults).apply(pulumi.runtime.to_json) if s3_defaults is not None else None
+            if show_terraform_logs is None:
+                show_terraform_logs = True
+            __props__.__dict__["show_terraform_logs"] = pulumi.Output.from_input(show_terraform_logs).apply(pulumi.runtime.to_json) if show_terraform_logs is not None else None
+            __props__.__dict__["timeout"] = timeout
         super(Provider, __self__).__init__(
             'terraform',
             resource_name,
             __props__,
             opts)
 
+    @_builtins.property
+    @pulumi.getter(name="cacheTtl")
+    def cache_ttl(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        How long a cached state is reused, as a duration such as 30s or 5m.
+        """
+        return pulumi.get(self, "cache_ttl")
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The default time to wait for state to be read, as a duration such as 30s or 2m. Functions override it with their own timeout argument, but reads shared through the cache are always bounded by it. Reads are unbounded when unset.
+        """
+        return pulumi.get(self, "timeout")
+
@@ -7,7 +7,21 @@ from .. import _utilities
 import typing
 # Export this package's modules as members:
 from .get_azure_rm_reference import *
+from .get_backend_reference import *
+from .get_cloud_reference import *
+from .get_consul_reference import *
+from .get_cos_reference import *
+from .get_gcs_reference import *
+from .get_http_reference import *
+from .get_kubernetes_reference import *
 from .get_local_reference import *
+from .get_oss_reference import *
+from .get_output import *
+from .get_pg_reference import *
 from .get_remote_reference import *
 from .get_s3_reference import *
+from .get_workspace_references import *
+from .list_workspaces import *
+from .reference import *
 from ._inputs import *
+from . import outputs
@@ -15,10 +15,1123 @@ else:
 from .. import _utilities
 
 __all__ = [
+    'AzureRMDefaultsArgs',
+    'AzureRMDefaultsArgsDict',
+    'CloudWorkspaces',
+    'CloudWorkspacesDict',
+    'CosAssumeRole',
+    'CosAssumeRoleDict',
+    'GcsDefaultsArgs',
+    'GcsDefaultsArgsDict',
+    'KubernetesExec',
+    'KubernetesExecDict',
+    'RemoteDefaultsArgs',
+    'RemoteDefaultsArgsDict',
+    'S3DefaultsArgs',
+    'S3DefaultsArgsDict',
     'Workspaces',
     'WorkspacesDict',
 ]
 
+class AzureRMDefaultsArgsDict(TypedDict):
+    client_certificate_password: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The password of the default client certificate.
+    """
+    client_certificate_path: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default path to the PFX file used as the client certificate.
+    """
+    client_id: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default client ID to authenticate as.
+    """
+    client_secret: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default client secret used for service principal authentication.
+    """
+    endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default custom endpoint for the Azure Resource Manager API.
+    """
+    environment: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default Azure cloud environment.
+    """
+    metadata_host: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default hostname of the Azure metadata service.
+    """
+    msi_endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default endpoint of the Managed Service Identity.
+    """
+    oidc_request_token: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default bearer token for requests to oidcRequestUrl.
+    """
+    oidc_request_url: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default URL of the OIDC provider to request an ID token from.
+    """
+    oidc_token: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default JWT token for OIDC authentication.
+    """
+    oidc_token_file_path: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default path to a file containing a JWT token for OIDC authentication.
+    """
+    subscription_id: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default subscription ID holding the storage account.
+    """
+    tenant_id: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default tenant ID to authenticate against.
+    """
+    use_azuread_auth: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to authenticate against the storage container with AzureAD by default.
+    """
+    use_msi: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to authenticate using Managed Service Identity by default.
+    """
+    use_oidc: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to authenticate using OIDC by default.
+    """
+
+@pulumi.input_type
+class AzureRMDefaultsArgs:
+    def __init__(__self__, *,
+                 client_certificate_password: pulumi.Input[Optional[_builtins.str]] = None,
+                 client_certificate_path: pulumi.Input[Optional[_builtins.str]] = None,
+                 client_id: pulumi.Input[Optional[_builtins.str]] = None,
+                 client_secret: pulumi.Input[Optional[_builtins.str]] = None,
+                 endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 environment: pulumi.Input[Optional[_builtins.str]] = None,
+                 metadata_host: pulumi.Input[Optional[_builtins.str]] = None,
+                 msi_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 oidc_request_token: pulumi.Input[Optional[_builtins.str]] = None,
+                 oidc_request_url: pulumi.Input[Optional[_builtins.str]] = None,
+                 oidc_token: pulumi.Input[Optional[_builtins.str]] = None,
+                 oidc_token_file_path: pulumi.Input[Optional[_builtins.str]] = None,
+                 subscription_id: pulumi.Input[Optional[_builtins.str]] = None,
+                 tenant_id: pulumi.Input[Optional[_builtins.str]] = None,
+                 use_azuread_auth: pulumi.Input[Optional[_builtins.bool]] = None,
+                 use_msi: pulumi.Input[Optional[_builtins.bool]] = None,
+                 use_oidc: pulumi.Input[Optional[_builtins.bool]] = None):
+        """
+        :param pulumi.Input[_builtins.str] client_certificate_password: The password of the default client certificate.
+        :param pulumi.Input[_builtins.str] client_certificate_path: The default path to the PFX file used as the client certificate.
+        :param pulumi.Input[_builtins.str] client_id: The default client ID to authenticate as.
+        :param pulumi.Input[_builtins.str] client_secret: The default client secret used for service principal authentication.
+        :param pulumi.Input[_builtins.str] endpoint: The default custom endpoint for the Azure Resource Manager API.
+        :param pulumi.Input[_builtins.str] environment: The default Azure cloud environment.
+        :param pulumi.Input[_builtins.str] metadata_host: The default hostname of the Azure metadata service.
+        :param pulumi.Input[_builtins.str] msi_endpoint: The default endpoint of the Managed Service Identity.
+        :param pulumi.Input[_builtins.str] oidc_request_token: The default bearer token for requests to oidcRequestUrl.
+        :param pulumi.Input[_builtins.str] oidc_request_url: The default URL of the OIDC provider to request an ID token from.
+        :param pulumi.Input[_builtins.str] oidc_token: The default JWT token for OIDC authentication.
+        :param pulumi.Input[_builtins.str] oidc_token_file_path: The default path to a file containing a JWT token for OIDC authentication.
+        :param pulumi.Input[_builtins.str] subscription_id: The default subscription ID holding the storage account.
+        :param pulumi.Input[_builtins.str] tenant_id: The default tenant ID to authenticate against.
+        :param pulumi.Input[_builtins.bool] use_azuread_auth: Whether to authenticate against the storage container with AzureAD by default.
+        :param pulumi.Input[_builtins.bool] use_msi: Whether to authenticate using Managed Service Identity by default.
+        :param pulumi.Input[_builtins.bool] use_oidc: Whether to authenticate using OIDC by default.
+        """
+        if client_certificate_password is not None:
+            pulumi.set(__self__, "client_certificate_password", client_certificate_password)
+        if client_certificate_path is not None:
+            pulumi.set(__self__, "client_certificate_path", client_certificate_path)
+        if client_id is not None:
+            pulumi.set(__self__, "client_id", client_id)
+        if client_secret is not None:
+            pulumi.set(__self__, "client_secret", client_secret)
+        if endpoint is not None:
+            pulumi.set(__self__, "endpoint", endpoint)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if metadata_host is not None:
+            pulumi.set(__self__, "metadata_host", metadata_host)
+        if msi_endpoint is not None:
+            pulumi.set(__self__, "msi_endpoint", msi_endpoint)
+        if oidc_request_token is not None:
+            pulumi.set(__self__, "oidc_request_token", oidc_request_token)
+        if oidc_request_url is not None:
+            pulumi.set(__self__, "oidc_request_url", oidc_request_url)
+        if oidc_token is not None:
+            pulumi.set(__self__, "oidc_token", oidc_token)
+        if oidc_token_file_path is not None:
+            pulumi.set(__self__, "oidc_token_file_path", oidc_token_file_path)
+        if subscription_id is not None:
+            pulumi.set(__self__, "subscription_id", subscription_id)
+        if tenant_id is not None:
+            pulumi.set(__self__, "tenant_id", tenant_id)
+        if use_azuread_auth is not None:
+            pulumi.set(__self__, "use_azuread_auth", use_azuread_auth)
+        if use_msi is not None:
+            pulumi.set(__self__, "use_msi", use_msi)
+        if use_oidc is not None:
+            pulumi.set(__self__, "use_oidc", use_oidc)
+
+    @_builtins.property
+    @pulumi.getter(name="clientCertificatePassword")
+    def client_certificate_password(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The password of the default client certificate.
+        """
+        return pulumi.get(self, "client_certificate_password")
+
+    @client_certificate_password.setter
+    def client_certificate_password(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "client_certificate_password", value)
+
+    @_builtins.property
+    @pulumi.getter(name="clientCertificatePath")
+    def client_certificate_path(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default path to the PFX file used as the client certificate.
+        """
+        return pulumi.get(self, "client_certificate_path")
+
+    @client_certificate_path.setter
+    def client_certificate_path(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "client_certificate_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="clientId")
+    def client_id(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default client ID to authenticate as.
+        """
+        return pulumi.get(self, "client_id")
+
+    @client_id.setter
+    def client_id(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "client_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="clientSecret")
+    def client_secret(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default client secret used for service principal authentication.
+        """
+        return pulumi.get(self, "client_secret")
+
+    @client_secret.setter
+    def client_secret(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "client_secret", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default custom endpoint for the Azure Resource Manager API.
+        """
+        return pulumi.get(self, "endpoint")
+
+    @endpoint.setter
+    def endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def environment(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default Azure cloud environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "environment", value)
+
+    @_builtins.property
+    @pulumi.getter(name="metadataHost")
+    def metadata_host(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default hostname of the Azure metadata service.
+        """
+        return pulumi.get(self, "metadata_host")
+
+    @metadata_host.setter
+    def metadata_host(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "metadata_host", value)
+
+    @_builtins.property
+    @pulumi.getter(name="msiEndpoint")
+    def msi_endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default endpoint of the Managed Service Identity.
+        """
+        return pulumi.get(self, "msi_endpoint")
+
+    @msi_endpoint.setter
+    def msi_endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "msi_endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcRequestToken")
+    def oidc_request_token(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default bearer token for requests to oidcRequestUrl.
+        """
+        return pulumi.get(self, "oidc_request_token")
+
+    @oidc_request_token.setter
+    def oidc_request_token(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "oidc_request_token", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcRequestUrl")
+    def oidc_request_url(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default URL of the OIDC provider to request an ID token from.
+        """
+        return pulumi.get(self, "oidc_request_url")
+
+    @oidc_request_url.setter
+    def oidc_request_url(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "oidc_request_url", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcToken")
+    def oidc_token(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default JWT token for OIDC authentication.
+        """
+        return pulumi.get(self, "oidc_token")
+
+    @oidc_token.setter
+    def oidc_token(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "oidc_token", value)
+
+    @_builtins.property
+    @pulumi.getter(name="oidcTokenFilePath")
+    def oidc_token_file_path(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default path to a file containing a JWT token for OIDC authentication.
+        """
+        return pulumi.get(self, "oidc_token_file_path")
+
+    @oidc_token_file_path.setter
+    def oidc_token_file_path(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "oidc_token_file_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="subscriptionId")
+    def subscription_id(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default subscription ID holding the storage account.
+        """
+        return pulumi.get(self, "subscription_id")
+
+    @subscription_id.setter
+    def subscription_id(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "subscription_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="tenantId")
+    def tenant_id(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default tenant ID to authenticate against.
+        """
+        return pulumi.get(self, "tenant_id")
+
+    @tenant_id.setter
+    def tenant_id(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "tenant_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="useAzureadAuth")
+    def use_azuread_auth(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to authenticate against the storage container with AzureAD by default.
+        """
+        return pulumi.get(self, "use_azuread_auth")
+
+    @use_azuread_auth.setter
+    def use_azuread_auth(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "use_azuread_auth", value)
+
+    @_builtins.property
+    @pulumi.getter(name="useMsi")
+    def use_msi(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to authenticate using Managed Service Identity by default.
+        """
+        return pulumi.get(self, "use_msi")
+
+    @use_msi.setter
+    def use_msi(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "use_msi", value)
+
+    @_builtins.property
+    @pulumi.getter(name="useOidc")
+    def use_oidc(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to authenticate using OIDC by default.
+        """
+        return pulumi.get(self, "use_oidc")
+
+    @use_oidc.setter
+    def use_oidc(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "use_oidc", value)
+
+
+class CloudWorkspacesDict(TypedDict):
+    name: NotRequired[_builtins.str]
+    """
+    The name of a single workspace. This option conflicts with tags.
+    """
+    tags: NotRequired[Sequence[_builtins.str]]
+    """
+    The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+    """
+
+@pulumi.input_type
+class CloudWorkspaces:
+    def __init__(__self__, *,
+                 name: Optional[_builtins.str] = None,
+                 tags: Optional[Sequence[_builtins.str]] = None):
+        """
+        :param _builtins.str name: The name of a single workspace. This option conflicts with tags.
+        :param Sequence[_builtins.str] tags: The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+        """
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[_builtins.str]:
+        """
+        The name of a single workspace. This option conflicts with tags.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The tags shared by the workspaces the configuration maps to. Use workspace to choose which of them to read. This option conflicts with name.
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "tags", value)
+
+
+class CosAssumeRoleDict(TypedDict):
+    role_arn: _builtins.str
+    """
+    The ARN of the role to assume.
+    """
+    session_name: _builtins.str
+    """
+    The session name to use when assuming the role.
+    """
+    policy: NotRequired[_builtins.str]
+    """
+    A policy further restricting the permissions of the assumed role.
+    """
+    session_duration: NotRequired[_builtins.int]
+    """
+    The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+    """
+
+@pulumi.input_type
+class CosAssumeRole:
+    def __init__(__self__, *,
+                 role_arn: _builtins.str,
+                 session_name: _builtins.str,
+                 policy: Optional[_builtins.str] = None,
+                 session_duration: Optional[_builtins.int] = None):
+        """
+        :param _builtins.str role_arn: The ARN of the role to assume.
+        :param _builtins.str session_name: The session name to use when assuming the role.
+        :param _builtins.str policy: A policy further restricting the permissions of the assumed role.
+        :param _builtins.int session_duration: The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+        """
+        pulumi.set(__self__, "role_arn", role_arn)
+        pulumi.set(__self__, "session_name", session_name)
+        if policy is not None:
+            pulumi.set(__self__, "policy", policy)
+        if session_duration is not None:
+            pulumi.set(__self__, "session_duration", session_duration)
+
+    @_builtins.property
+    @pulumi.getter(name="roleArn")
+    def role_arn(self) -> _builtins.str:
+        """
+        The ARN of the role to assume.
+        """
+        return pulumi.get(self, "role_arn")
+
+    @role_arn.setter
+    def role_arn(self, value: _builtins.str):
+        pulumi.set(self, "role_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="sessionName")
+    def session_name(self) -> _builtins.str:
+        """
+        The session name to use when assuming the role.
+        """
+        return pulumi.get(self, "session_name")
+
+    @session_name.setter
+    def session_name(self, value: _builtins.str):
+        pulumi.set(self, "session_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def policy(self) -> Optional[_builtins.str]:
+        """
+        A policy further restricting the permissions of the assumed role.
+        """
+        return pulumi.get(self, "policy")
+
+    @policy.setter
+    def policy(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "policy", value)
+
+    @_builtins.property
+    @pulumi.getter(name="sessionDuration")
+    def session_duration(self) -> Optional[_builtins.int]:
+        """
+        The duration, in seconds, of the assume role session, between 0 and 43200. Defaults to 7200.
+        """
+        return pulumi.get(self, "session_duration")
+
+    @session_duration.setter
+    def session_duration(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "session_duration", value)
+
+
+class GcsDefaultsArgsDict(TypedDict):
+    access_token: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default temporary OAuth 2.0 access token.
+    """
+    credentials: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+    """
+    impersonate_service_account: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The service account to impersonate by default.
+    """
+    impersonate_service_account_delegates: NotRequired[pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]]
+    """
+    The default delegation chain for impersonating impersonateServiceAccount.
+    """
+    storage_custom_endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default custom endpoint for the Cloud Storage API.
+    """
+
+@pulumi.input_type
+class GcsDefaultsArgs:
+    def __init__(__self__, *,
+                 access_token: pulumi.Input[Optional[_builtins.str]] = None,
+                 credentials: pulumi.Input[Optional[_builtins.str]] = None,
+                 impersonate_service_account: pulumi.Input[Optional[_builtins.str]] = None,
+                 impersonate_service_account_delegates: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 storage_custom_endpoint: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        :param pulumi.Input[_builtins.str] access_token: The default temporary OAuth 2.0 access token.
+        :param pulumi.Input[_builtins.str] credentials: The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+        :param pulumi.Input[_builtins.str] impersonate_service_account: The service account to impersonate by default.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] impersonate_service_account_delegates: The default delegation chain for impersonating impersonateServiceAccount.
+        :param pulumi.Input[_builtins.str] storage_custom_endpoint: The default custom endpoint for the Cloud Storage API.
+        """
+        if access_token is not None:
+            pulumi.set(__self__, "access_token", access_token)
+        if credentials is not None:
+            pulumi.set(__self__, "credentials", credentials)
+        if impersonate_service_account is not None:
+            pulumi.set(__self__, "impersonate_service_account", impersonate_service_account)
+        if impersonate_service_account_delegates is not None:
+            pulumi.set(__self__, "impersonate_service_account_delegates", impersonate_service_account_delegates)
+        if storage_custom_endpoint is not None:
+            pulumi.set(__self__, "storage_custom_endpoint", storage_custom_endpoint)
+
+    @_builtins.property
+    @pulumi.getter(name="accessToken")
+    def access_token(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default temporary OAuth 2.0 access token.
+        """
+        return pulumi.get(self, "access_token")
+
+    @access_token.setter
+    def access_token(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "access_token", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def credentials(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+        """
+        return pulumi.get(self, "credentials")
+
+    @credentials.setter
+    def credentials(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "credentials", value)
+
+    @_builtins.property
+    @pulumi.getter(name="impersonateServiceAccount")
+    def impersonate_service_account(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The service account to impersonate by default.
+        """
+        return pulumi.get(self, "impersonate_service_account")
+
+    @impersonate_service_account.setter
+    def impersonate_service_account(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "impersonate_service_account", value)
+
+    @_builtins.property
+    @pulumi.getter(name="impersonateServiceAccountDelegates")
+    def impersonate_service_account_delegates(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The default delegation chain for impersonating impersonateServiceAccount.
+        """
+        return pulumi.get(self, "impersonate_service_account_delegates")
+
+    @impersonate_service_account_delegates.setter
+    def impersonate_service_account_delegates(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "impersonate_service_account_delegates", value)
+
+    @_builtins.property
+    @pulumi.getter(name="storageCustomEndpoint")
+    def storage_custom_endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default custom endpoint for the Cloud Storage API.
+        """
+        return pulumi.get(self, "storage_custom_endpoint")
+
+    @storage_custom_endpoint.setter
+    def storage_custom_endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "storage_custom_endpoint", value)
+
+
+class KubernetesExecDict(TypedDict):
+    api_version: _builtins.str
+    """
+    The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+    """
+    command: _builtins.str
+    """
+    The command to run.
+    """
+    args: NotRequired[Sequence[_builtins.str]]
+    """
+    The arguments passed to the command.
+    """
+    env: NotRequired[Mapping[str, _builtins.str]]
+    """
+    Additional environment variables for the command.
+    """
+
+@pulumi.input_type
+class KubernetesExec:
+    def __init__(__self__, *,
+                 api_version: _builtins.str,
+                 command: _builtins.str,
+                 args: Optional[Sequence[_builtins.str]] = None,
+                 env: Optional[Mapping[str, _builtins.str]] = None):
+        """
+        :param _builtins.str api_version: The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+        :param _builtins.str command: The command to run.
+        :param Sequence[_builtins.str] args: The arguments passed to the command.
+        :param Mapping[str, _builtins.str] env: Additional environment variables for the command.
+        """
+        pulumi.set(__self__, "api_version", api_version)
+        pulumi.set(__self__, "command", command)
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+
+    @_builtins.property
+    @pulumi.getter(name="apiVersion")
+    def api_version(self) -> _builtins.str:
+        """
+        The API version of the ExecCredential the plugin returns, e.g. client.authentication.k8s.io/v1beta1.
+        """
+        return pulumi.get(self, "api_version")
+
+    @api_version.setter
+    def api_version(self, value: _builtins.str):
+        pulumi.set(self, "api_version", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def command(self) -> _builtins.str:
+        """
+        The command to run.
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: _builtins.str):
+        pulumi.set(self, "command", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The arguments passed to the command.
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "args", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Additional environment variables for the command.
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[Mapping[str, _builtins.str]]):
+        pulumi.set(self, "env", value)
+
+
+class RemoteDefaultsArgsDict(TypedDict):
+    hostname: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default hostname to connect to.
+    """
+    token: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default token used to authenticate with the backend.
+    """
+
+@pulumi.input_type
+class RemoteDefaultsArgs:
+    def __init__(__self__, *,
+                 hostname: pulumi.Input[Optional[_builtins.str]] = None,
+                 token: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        :param pulumi.Input[_builtins.str] hostname: The default hostname to connect to.
+        :param pulumi.Input[_builtins.str] token: The default token used to authenticate with the backend.
+        """
+        if hostname is not None:
+            pulumi.set(__self__, "hostname", hostname)
+        if token is not None:
+            pulumi.set(__self__, "token", token)
+
+    @_builtins.property
+    @pulumi.getter
+    def hostname(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default hostname to connect to.
+        """
+        return pulumi.get(self, "hostname")
+
+    @hostname.setter
+    def hostname(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "hostname", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def token(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default token used to authenticate with the backend.
+        """
+        return pulumi.get(self, "token")
+
+    @token.setter
+    def token(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "token", value)
+
+
+class S3DefaultsArgsDict(TypedDict):
+    access_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default AWS access key.
+    """
+    assume_role_duration_seconds: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The default duration, in seconds, of the assume role session.
+    """
+    endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default custom endpoint for the S3 API.
+    """
+    external_id: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default external ID to use when assuming the role.
+    """
+    force_path_style: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to force path-style addressing by default.
+    """
+    iam_endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default custom endpoint for the IAM API.
+    """
+    max_retries: NotRequired[pulumi.Input[Optional[_builtins.int]]]
+    """
+    The default maximum number of times an AWS API request is retried.
+    """
+    profile: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default AWS profile name.
+    """
+    region: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default AWS region of the S3 bucket.
+    """
+    role_arn: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The ARN of the IAM Role assumed by default in order to read the state.
+    """
+    secret_key: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default AWS secret key.
+    """
+    session_name: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default session name to use when assuming the role.
+    """
+    shared_credentials_file: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default path to a shared credentials file.
+    """
+    skip_credentials_validation: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to skip the credentials validation by default.
+    """
+    skip_metadata_api_check: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to skip the AWS Metadata API check by default.
+    """
+    skip_region_validation: NotRequired[pulumi.Input[Optional[_builtins.bool]]]
+    """
+    Whether to skip static validation of the region name by default.
+    """
+    sts_endpoint: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default custom endpoint for the STS API.
+    """
+    token: NotRequired[pulumi.Input[Optional[_builtins.str]]]
+    """
+    The default AWS session token.
+    """
+
+@pulumi.input_type
+class S3DefaultsArgs:
+    def __init__(__self__, *,
+                 access_key: pulumi.Input[Optional[_builtins.str]] = None,
+                 assume_role_duration_seconds: pulumi.Input[Optional[_builtins.int]] = None,
+                 endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 external_id: pulumi.Input[Optional[_builtins.str]] = None,
+                 force_path_style: pulumi.Input[Optional[_builtins.bool]] = None,
+                 iam_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 max_retries: pulumi.Input[Optional[_builtins.int]] = None,
+                 profile: pulumi.Input[Optional[_builtins.str]] = None,
+                 region: pulumi.Input[Optional[_builtins.str]] = None,
+                 role_arn: pulumi.Input[Optional[_builtins.str]] = None,
+                 secret_key: pulumi.Input[Optional[_builtins.str]] = None,
+                 session_name: pulumi.Input[Optional[_builtins.str]] = None,
+                 shared_credentials_file: pulumi.Input[Optional[_builtins.str]] = None,
+                 skip_credentials_validation: pulumi.Input[Optional[_builtins.bool]] = None,
+                 skip_metadata_api_check: pulumi.Input[Optional[_builtins.bool]] = None,
+                 skip_region_validation: pulumi.Input[Optional[_builtins.bool]] = None,
+                 sts_endpoint: pulumi.Input[Optional[_builtins.str]] = None,
+                 token: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        :param pulumi.Input[_builtins.str] access_key: The default AWS access key.
+        :param pulumi.Input[_builtins.int] assume_role_duration_seconds: The default duration, in seconds, of the assume role session.
+        :param pulumi.Input[_builtins.str] endpoint: The default custom endpoint for the S3 API.
+        :param pulumi.Input[_builtins.str] external_id: The default external ID to use when assuming the role.
+        :param pulumi.Input[_builtins.bool] force_path_style: Whether to force path-style addressing by default.
+        :param pulumi.Input[_builtins.str] iam_endpoint: The default custom endpoint for the IAM API.
+        :param pulumi.Input[_builtins.int] max_retries: The default maximum number of times an AWS API request is retried.
+        :param pulumi.Input[_builtins.str] profile: The default AWS profile name.
+        :param pulumi.Input[_builtins.str] region: The default AWS region of the S3 bucket.
+        :param pulumi.Input[_builtins.str] role_arn: The ARN of the IAM Role assumed by default in order to read the state.
+        :param pulumi.Input[_builtins.str] secret_key: The default AWS secret key.
+        :param pulumi.Input[_builtins.str] session_name: The default session name to use when assuming the role.
+        :param pulumi.Input[_builtins.str] shared_credentials_file: The default path to a shared credentials file.
+        :param pulumi.Input[_builtins.bool] skip_credentials_validation: Whether to skip the credentials validation by default.
+        :param pulumi.Input[_builtins.bool] skip_metadata_api_check: Whether to skip the AWS Metadata API check by default.
+        :param pulumi.Input[_builtins.bool] skip_region_validation: Whether to skip static validation of the region name by default.
+        :param pulumi.Input[_builtins.str] sts_endpoint: The default custom endpoint for the STS API.
+        :param pulumi.Input[_builtins.str] token: The default AWS session token.
+        """
+        if access_key is not None:
+            pulumi.set(__self__, "access_key", access_key)
+        if assume_role_duration_seconds is not None:
+            pulumi.set(__self__, "assume_role_duration_seconds", assume_role_duration_seconds)
+        if endpoint is not None:
+            pulumi.set(__self__, "endpoint", endpoint)
+        if external_id is not None:
+            pulumi.set(__self__, "external_id", external_id)
+        if force_path_style is not None:
+            pulumi.set(__self__, "force_path_style", force_path_style)
+        if iam_endpoint is not None:
+            pulumi.set(__self__, "iam_endpoint", iam_endpoint)
+        if max_retries is not None:
+            pulumi.set(__self__, "max_retries", max_retries)
+        if profile is not None:
+            pulumi.set(__self__, "profile", profile)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+        if role_arn is not None:
+            pulumi.set(__self__, "role_arn", role_arn)
+        if secret_key is not None:
+            pulumi.set(__self__, "secret_key", secret_key)
+        if session_name is not None:
+            pulumi.set(__self__, "session_name", session_name)
+        if shared_credentials_file is not None:
+            pulumi.set(__self__, "shared_credentials_file", shared_credentials_file)
+        if skip_credentials_validation is not None:
+            pulumi.set(__self__, "skip_credentials_validation", skip_credentials_validation)
+        if skip_metadata_api_check is not None:
+            pulumi.set(__self__, "skip_metadata_api_check", skip_metadata_api_check)
+        if skip_region_validation is not None:
+            pulumi.set(__self__, "skip_region_validation", skip_region_validation)
+        if sts_endpoint is not None:
+            pulumi.set(__self__, "sts_endpoint", sts_endpoint)
+        if token is not None:
+            pulumi.set(__self__, "token", token)
+
+    @_builtins.property
+    @pulumi.getter(name="accessKey")
+    def access_key(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default AWS access key.
+        """
+        return pulumi.get(self, "access_key")
+
+    @access_key.setter
+    def access_key(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "access_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="assumeRoleDurationSeconds")
+    def assume_role_duration_seconds(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The default duration, in seconds, of the assume role session.
+        """
+        return pulumi.get(self, "assume_role_duration_seconds")
+
+    @assume_role_duration_seconds.setter
+    def assume_role_duration_seconds(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "assume_role_duration_seconds", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default custom endpoint for the S3 API.
+        """
+        return pulumi.get(self, "endpoint")
+
+    @endpoint.setter
+    def endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter(name="externalId")
+    def external_id(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default external ID to use when assuming the role.
+        """
+        return pulumi.get(self, "external_id")
+
+    @external_id.setter
+    def external_id(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "external_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="forcePathStyle")
+    def force_path_style(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to force path-style addressing by default.
+        """
+        return pulumi.get(self, "force_path_style")
+
+    @force_path_style.setter
+    def force_path_style(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "force_path_style", value)
+
+    @_builtins.property
+    @pulumi.getter(name="iamEndpoint")
+    def iam_endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default custom endpoint for the IAM API.
+        """
+        return pulumi.get(self, "iam_endpoint")
+
+    @iam_endpoint.setter
+    def iam_endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "iam_endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter(name="maxRetries")
+    def max_retries(self) -> pulumi.Input[Optional[_builtins.int]]:
+        """
+        The default maximum number of times an AWS API request is retried.
+        """
+        return pulumi.get(self, "max_retries")
+
+    @max_retries.setter
+    def max_retries(self, value: pulumi.Input[Optional[_builtins.int]]):
+        pulumi.set(self, "max_retries", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def profile(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default AWS profile name.
+        """
+        return pulumi.get(self, "profile")
+
+    @profile.setter
+    def profile(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "profile", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default AWS region of the S3 bucket.
+        """
+        return pulumi.get(self, "region")
+
+    @region.setter
+    def region(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "region", value)
+
+    @_builtins.property
+    @pulumi.getter(name="roleArn")
+    def role_arn(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The ARN of the IAM Role assumed by default in order to read the state.
+        """
+        return pulumi.get(self, "role_arn")
+
+    @role_arn.setter
+    def role_arn(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "role_arn", value)
+
+    @_builtins.property
+    @pulumi.getter(name="secretKey")
+    def secret_key(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default AWS secret key.
+        """
+        return pulumi.get(self, "secret_key")
+
+    @secret_key.setter
+    def secret_key(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "secret_key", value)
+
+    @_builtins.property
+    @pulumi.getter(name="sessionName")
+    def session_name(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default session name to use when assuming the role.
+        """
+        return pulumi.get(self, "session_name")
+
+    @session_name.setter
+    def session_name(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "session_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="sharedCredentialsFile")
+    def shared_credentials_file(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default path to a shared credentials file.
+        """
+        return pulumi.get(self, "shared_credentials_file")
+
+    @shared_credentials_file.setter
+    def shared_credentials_file(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "shared_credentials_file", value)
+
+    @_builtins.property
+    @pulumi.getter(name="skipCredentialsValidation")
+    def skip_credentials_validation(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to skip the credentials validation by default.
+        """
+        return pulumi.get(self, "skip_credentials_validation")
+
+    @skip_credentials_validation.setter
+    def skip_credentials_validation(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "skip_credentials_validation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="skipMetadataApiCheck")
+    def skip_metadata_api_check(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to skip the AWS Metadata API check by default.
+        """
+        return pulumi.get(self, "skip_metadata_api_check")
+
+    @skip_metadata_api_check.setter
+    def skip_metadata_api_check(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "skip_metadata_api_check", value)
+
+    @_builtins.property
+    @pulumi.getter(name="skipRegionValidation")
+    def skip_region_validation(self) -> pulumi.Input[Optional[_builtins.bool]]:
+        """
+        Whether to skip static validation of the region name by default.
+        """
+        return pulumi.get(self, "skip_region_validation")
+
+    @skip_region_validation.setter
+    def skip_region_validation(self, value: pulumi.Input[Optional[_builtins.bool]]):
+        pulumi.set(self, "skip_region_validation", value)
+
+    @_builtins.property
+    @pulumi.getter(name="stsEndpoint")
+    def sts_endpoint(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default custom endpoint for the STS API.
+        """
+        return pulumi.get(self, "sts_endpoint")
+
+    @sts_endpoint.setter
+    def sts_endpoint(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "sts_endpoint", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def token(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The default AWS session token.
+        """
+        return pulumi.get(self, "token")
+
+    @token.setter
+    def token(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "token", value)
+
+
 class WorkspacesDict(TypedDict):
     name: NotRequired[_builtins.str]
     """
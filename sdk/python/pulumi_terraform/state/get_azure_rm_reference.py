@@ -13,6 +13,7 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from . import outputs
 
 __all__ = [
     'GetAzureRMReferenceResult',
@@ -26,19 +27,74 @@ class GetAzureRMReferenceResult:
     """
     The result of fetching from a Terraform state store.
     """
-    def __init__(__self__, outputs=None):
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
         if outputs and not isinstance(outputs, dict):
             raise TypeError("Expected argument 'outputs' to be a dict")
         pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
 
     @_builtins.property
     @pulumi.getter
     def outputs(self) -> Mapping[str, Any]:
         """
-        The outputs displayed from Terraform state.
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
         """
         return pulumi.get(self, "outputs")
 
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
 
 class AwaitableGetAzureRMReferenceResult(GetAzureRMReferenceResult):
     # pylint: disable=using-constant-test
@@ -46,7 +102,12 @@ class AwaitableGetAzureRMReferenceResult(GetAzureRMReferenceResult):
         if False:
             yield self
         return GetAzureRMReferenceResult(
-            outputs=self.outputs)
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
 
 
 def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
@@ -56,7 +117,9 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
                            client_secret: Optional[_builtins.str] = None,
                            container_name: Optional[_builtins.str] = None,
                            endpoint: Optional[_builtins.str] = None,
+                           env: Optional[Mapping[str, _builtins.str]] = None,
                            environment: Optional[_builtins.str] = None,
+                           include_resources: Optional[_builtins.bool] = None,
                            key: Optional[_builtins.str] = None,
                            metadata_host: Optional[_builtins.str] = None,
                            msi_endpoint: Optional[_builtins.str] = None,
@@ -64,11 +127,15 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
                            oidc_request_url: Optional[_builtins.str] = None,
                            oidc_token: Optional[_builtins.str] = None,
                            oidc_token_file_path: Optional[_builtins.str] = None,
+                           outputs: Optional[Sequence[_builtins.str]] = None,
+                           required_outputs: Optional[Sequence[_builtins.str]] = None,
                            resource_group_name: Optional[_builtins.str] = None,
+                           resources: Optional[Sequence[_builtins.str]] = None,
                            sas_token: Optional[_builtins.str] = None,
                            storage_account_name: Optional[_builtins.str] = None,
                            subscription_id: Optional[_builtins.str] = None,
                            tenant_id: Optional[_builtins.str] = None,
+                           timeout: Optional[_builtins.str] = None,
                            use_azuread_auth: Optional[_builtins.bool] = None,
                            use_msi: Optional[_builtins.bool] = None,
                            use_oidc: Optional[_builtins.bool] = None,
@@ -84,7 +151,9 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
     :param _builtins.str client_secret: The client secret used for service principal authentication. Falls back to the ARM_CLIENT_SECRET environment variable when unset.
     :param _builtins.str container_name: The name of the storage container within the storage account.
     :param _builtins.str endpoint: A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
     :param _builtins.str environment: The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
     :param _builtins.str key: The name of the blob holding the Terraform state file inside the storage container.
     :param _builtins.str metadata_host: The hostname of the Azure metadata service used to obtain the cloud environment. Falls back to the ARM_METADATA_HOST environment variable when unset.
     :param _builtins.str msi_endpoint: The endpoint of the Managed Service Identity. Falls back to the ARM_MSI_ENDPOINT environment variable when unset.
@@ -92,11 +161,15 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
     :param _builtins.str oidc_request_url: The URL of the OIDC provider to request an ID token from, e.g. in GitHub Actions. Requires oidcRequestToken. Falls back to the ARM_OIDC_REQUEST_URL or ACTIONS_ID_TOKEN_REQUEST_URL environment variables when unset.
     :param _builtins.str oidc_token: A JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN environment variable when unset.
     :param _builtins.str oidc_token_file_path: The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
     :param _builtins.str resource_group_name: The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
     :param _builtins.str sas_token: A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
     :param _builtins.str storage_account_name: The name of the storage account.
     :param _builtins.str subscription_id: The subscription ID holding the storage account. Falls back to the ARM_SUBSCRIPTION_ID environment variable when unset.
     :param _builtins.str tenant_id: The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
     :param _builtins.bool use_azuread_auth: Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
     :param _builtins.bool use_msi: Whether to authenticate using Managed Service Identity. Falls back to the ARM_USE_MSI environment variable when unset.
     :param _builtins.bool use_oidc: Whether to authenticate using OIDC. Falls back to the ARM_USE_OIDC environment variable when unset.
@@ -110,7 +183,9 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
     __args__['clientSecret'] = client_secret
     __args__['containerName'] = container_name
     __args__['endpoint'] = endpoint
+    __args__['env'] = env
     __args__['environment'] = environment
+    __args__['includeResources'] = include_resources
     __args__['key'] = key
     __args__['metadataHost'] = metadata_host
     __args__['msiEndpoint'] = msi_endpoint
@@ -118,11 +193,15 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
     __args__['oidcRequestUrl'] = oidc_request_url
     __args__['oidcToken'] = oidc_token
     __args__['oidcTokenFilePath'] = oidc_token_file_path
+    __args__['outputs'] = outputs
+    __args__['requiredOutputs'] = required_outputs
     __args__['resourceGroupName'] = resource_group_name
+    __args__['resources'] = resources
     __args__['sasToken'] = sas_token
     __args__['storageAccountName'] = storage_account_name
     __args__['subscriptionId'] = subscription_id
     __args__['tenantId'] = tenant_id
+    __args__['timeout'] = timeout
     __args__['useAzureadAuth'] = use_azuread_auth
     __args__['useMsi'] = use_msi
     __args__['useOidc'] = use_oidc
@@ -131,7 +210,12 @@ def get_azure_rm_reference(access_key: Optional[_builtins.str] = None,
     __ret__ = pulumi.runtime.invoke('terraform:state:getAzureRMReference', __args__, opts=opts, typ=GetAzureRMReferenceResult).value
 
     return AwaitableGetAzureRMReferenceResult(
-        outputs=pulumi.get(__ret__, 'outputs'))
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
 def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   client_certificate_password: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   client_certificate_path: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
@@ -139,7 +223,9 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
                                   client_secret: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   container_name: pulumi.Input[Optional[_builtins.str]] = None,
                                   endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                  env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
                                   environment: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                  include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                                   key: pulumi.Input[Optional[_builtins.str]] = None,
                                   metadata_host: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   msi_endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
@@ -147,11 +233,15 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
                                   oidc_request_url: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   oidc_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   oidc_token_file_path: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                  outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                  required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                                   resource_group_name: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                  resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                                   sas_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   storage_account_name: pulumi.Input[Optional[_builtins.str]] = None,
                                   subscription_id: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   tenant_id: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                  timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                                   use_azuread_auth: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                                   use_msi: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                                   use_oidc: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
@@ -167,7 +257,9 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
     :param _builtins.str client_secret: The client secret used for service principal authentication. Falls back to the ARM_CLIENT_SECRET environment variable when unset.
     :param _builtins.str container_name: The name of the storage container within the storage account.
     :param _builtins.str endpoint: A custom endpoint for the Azure Resource Manager API. Falls back to the ARM_ENDPOINT environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
     :param _builtins.str environment: The Azure cloud environment to use: public (default), china, german, stack or usgovernment. Falls back to the ARM_ENVIRONMENT environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
     :param _builtins.str key: The name of the blob holding the Terraform state file inside the storage container.
     :param _builtins.str metadata_host: The hostname of the Azure metadata service used to obtain the cloud environment. Falls back to the ARM_METADATA_HOST environment variable when unset.
     :param _builtins.str msi_endpoint: The endpoint of the Managed Service Identity. Falls back to the ARM_MSI_ENDPOINT environment variable when unset.
@@ -175,11 +267,15 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
     :param _builtins.str oidc_request_url: The URL of the OIDC provider to request an ID token from, e.g. in GitHub Actions. Requires oidcRequestToken. Falls back to the ARM_OIDC_REQUEST_URL or ACTIONS_ID_TOKEN_REQUEST_URL environment variables when unset.
     :param _builtins.str oidc_token: A JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN environment variable when unset.
     :param _builtins.str oidc_token_file_path: The path to a file containing a JWT token for OIDC authentication. Conflicts with oidcRequestToken. Falls back to the ARM_OIDC_TOKEN_FILE_PATH environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
     :param _builtins.str resource_group_name: The name of the resource group holding the storage account. Required when using AzureAD authentication against the Azure Resource Manager API to look up the storage access key.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
     :param _builtins.str sas_token: A SAS token for accessing the storage container. Falls back to the ARM_SAS_TOKEN environment variable when unset.
     :param _builtins.str storage_account_name: The name of the storage account.
     :param _builtins.str subscription_id: The subscription ID holding the storage account. Falls back to the ARM_SUBSCRIPTION_ID environment variable when unset.
     :param _builtins.str tenant_id: The tenant ID to authenticate against. Falls back to the ARM_TENANT_ID environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
     :param _builtins.bool use_azuread_auth: Whether to authenticate against the storage container with AzureAD instead of an access key. Falls back to the ARM_USE_AZUREAD environment variable when unset.
     :param _builtins.bool use_msi: Whether to authenticate using Managed Service Identity. Falls back to the ARM_USE_MSI environment variable when unset.
     :param _builtins.bool use_oidc: Whether to authenticate using OIDC. Falls back to the ARM_USE_OIDC environment variable when unset.
@@ -193,7 +289,9 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
     __args__['clientSecret'] = client_secret
     __args__['containerName'] = container_name
     __args__['endpoint'] = endpoint
+    __args__['env'] = env
     __args__['environment'] = environment
+    __args__['includeResources'] = include_resources
     __args__['key'] = key
     __args__['metadataHost'] = metadata_host
     __args__['msiEndpoint'] = msi_endpoint
@@ -201,11 +299,15 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
     __args__['oidcRequestUrl'] = oidc_request_url
     __args__['oidcToken'] = oidc_token
     __args__['oidcTokenFilePath'] = oidc_token_file_path
+    __args__['outputs'] = outputs
+    __args__['requiredOutputs'] = required_outputs
     __args__['resourceGroupName'] = resource_group_name
+    __args__['resources'] = resources
     __args__['sasToken'] = sas_token
     __args__['storageAccountName'] = storage_account_name
     __args__['subscriptionId'] = subscription_id
     __args__['tenantId'] = tenant_id
+    __args__['timeout'] = timeout
     __args__['useAzureadAuth'] = use_azuread_auth
     __args__['useMsi'] = use_msi
     __args__['useOidc'] = use_oidc
@@ -213,4 +315,9 @@ def get_azure_rm_reference_output(access_key: pulumi.Input[Optional[Optional[_bu
     opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
     __ret__ = pulumi.runtime.invoke_output('terraform:state:getAzureRMReference', __args__, opts=opts, typ=GetAzureRMReferenceResult)
     return __ret__.apply(lambda __response__: GetAzureRMReferenceResult(
-        outputs=pulumi.get(__response__, 'outputs')))
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
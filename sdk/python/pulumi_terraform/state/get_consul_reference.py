@@ -0,0 +1,239 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'GetConsulReferenceResult',
+    'AwaitableGetConsulReferenceResult',
+    'get_consul_reference',
+    'get_consul_reference_output',
+]
+
+@pulumi.output_type
+class GetConsulReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetConsulReferenceResult(GetConsulReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetConsulReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_consul_reference(access_token: Optional[_builtins.str] = None,
+                         address: Optional[_builtins.str] = None,
+                         ca_file: Optional[_builtins.str] = None,
+                         cert_file: Optional[_builtins.str] = None,
+                         datacenter: Optional[_builtins.str] = None,
+                         env: Optional[Mapping[str, _builtins.str]] = None,
+                         http_auth: Optional[_builtins.str] = None,
+                         include_resources: Optional[_builtins.bool] = None,
+                         key_file: Optional[_builtins.str] = None,
+                         outputs: Optional[Sequence[_builtins.str]] = None,
+                         path: Optional[_builtins.str] = None,
+                         required_outputs: Optional[Sequence[_builtins.str]] = None,
+                         resources: Optional[Sequence[_builtins.str]] = None,
+                         scheme: Optional[_builtins.str] = None,
+                         timeout: Optional[_builtins.str] = None,
+                         workspace: Optional[_builtins.str] = None,
+                         opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetConsulReferenceResult:
+    """
+    Access state stored in the Consul KV store.
+
+    :param _builtins.str access_token: An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+    :param _builtins.str address: The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+    :param _builtins.str ca_file: The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+    :param _builtins.str cert_file: The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+    :param _builtins.str datacenter: The datacenter to read from. Defaults to the datacenter of the agent.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str http_auth: HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key_file: The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str path: The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str scheme: The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessToken'] = access_token
+    __args__['address'] = address
+    __args__['caFile'] = ca_file
+    __args__['certFile'] = cert_file
+    __args__['datacenter'] = datacenter
+    __args__['env'] = env
+    __args__['httpAuth'] = http_auth
+    __args__['includeResources'] = include_resources
+    __args__['keyFile'] = key_file
+    __args__['outputs'] = outputs
+    __args__['path'] = path
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['scheme'] = scheme
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getConsulReference', __args__, opts=opts, typ=GetConsulReferenceResult).value
+
+    return AwaitableGetConsulReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_consul_reference_output(access_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                address: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                ca_file: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                cert_file: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                datacenter: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                                http_auth: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                                key_file: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                path: pulumi.Input[Optional[_builtins.str]] = None,
+                                required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                scheme: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetConsulReferenceResult]:
+    """
+    Access state stored in the Consul KV store.
+
+    :param _builtins.str access_token: An ACL token with read access to the path. Falls back to the CONSUL_HTTP_TOKEN environment variable when unset.
+    :param _builtins.str address: The DNS name and port of the Consul HTTP endpoint, e.g. consul.example.com:8500. Falls back to the CONSUL_HTTP_ADDR environment variable when unset.
+    :param _builtins.str ca_file: The path to a PEM-encoded certificate authority used to verify the agent's certificate. Falls back to the CONSUL_CACERT environment variable when unset.
+    :param _builtins.str cert_file: The path to a PEM-encoded certificate presented to the agent. Requires keyFile. Falls back to the CONSUL_CLIENT_CERT environment variable when unset.
+    :param _builtins.str datacenter: The datacenter to read from. Defaults to the datacenter of the agent.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str http_auth: HTTP basic authentication credentials, in the form username or username:password. Falls back to the CONSUL_HTTP_AUTH environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key_file: The path to the PEM-encoded private key of certFile. Falls back to the CONSUL_CLIENT_KEY environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str path: The path in the Consul KV store holding the state. When using a non-default workspace, the state path is <path>-env:<workspace>.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str scheme: The scheme used to talk to the Consul agent, http or https. Falls back to https when the CONSUL_HTTP_SSL environment variable is set.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessToken'] = access_token
+    __args__['address'] = address
+    __args__['caFile'] = ca_file
+    __args__['certFile'] = cert_file
+    __args__['datacenter'] = datacenter
+    __args__['env'] = env
+    __args__['httpAuth'] = http_auth
+    __args__['includeResources'] = include_resources
+    __args__['keyFile'] = key_file
+    __args__['outputs'] = outputs
+    __args__['path'] = path
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['scheme'] = scheme
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getConsulReference', __args__, opts=opts, typ=GetConsulReferenceResult)
+    return __ret__.apply(lambda __response__: GetConsulReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
@@ -0,0 +1,240 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetCosReferenceResult',
+    'AwaitableGetCosReferenceResult',
+    'get_cos_reference',
+    'get_cos_reference_output',
+]
+
+@pulumi.output_type
+class GetCosReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetCosReferenceResult(GetCosReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetCosReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_cos_reference(accelerate: Optional[_builtins.bool] = None,
+                      assume_role: Optional[Union['CosAssumeRole', 'CosAssumeRoleDict']] = None,
+                      bucket: Optional[_builtins.str] = None,
+                      env: Optional[Mapping[str, _builtins.str]] = None,
+                      include_resources: Optional[_builtins.bool] = None,
+                      key: Optional[_builtins.str] = None,
+                      outputs: Optional[Sequence[_builtins.str]] = None,
+                      prefix: Optional[_builtins.str] = None,
+                      region: Optional[_builtins.str] = None,
+                      required_outputs: Optional[Sequence[_builtins.str]] = None,
+                      resources: Optional[Sequence[_builtins.str]] = None,
+                      secret_id: Optional[_builtins.str] = None,
+                      secret_key: Optional[_builtins.str] = None,
+                      security_token: Optional[_builtins.str] = None,
+                      timeout: Optional[_builtins.str] = None,
+                      workspace: Optional[_builtins.str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetCosReferenceResult:
+    """
+    Access state stored in a Tencent Cloud Object Storage (COS) bucket.
+
+    :param _builtins.bool accelerate: Whether to read through the global acceleration endpoint of the bucket.
+    :param Union['CosAssumeRole', 'CosAssumeRoleDict'] assume_role: A CAM role to assume in order to read the state.
+    :param _builtins.str bucket: The name of the COS bucket, in the form <name>-<appid>.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key: The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory in the bucket holding the state file.
+    :param _builtins.str region: The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str secret_id: Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+    :param _builtins.str secret_key: Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+    :param _builtins.str security_token: The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accelerate'] = accelerate
+    __args__['assumeRole'] = assume_role
+    __args__['bucket'] = bucket
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['key'] = key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretId'] = secret_id
+    __args__['secretKey'] = secret_key
+    __args__['securityToken'] = security_token
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getCosReference', __args__, opts=opts, typ=GetCosReferenceResult).value
+
+    return AwaitableGetCosReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_cos_reference_output(accelerate: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                             assume_role: pulumi.Input[Optional[Optional[Union['CosAssumeRole', 'CosAssumeRoleDict']]]] = None,
+                             bucket: pulumi.Input[Optional[_builtins.str]] = None,
+                             env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                             include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                             key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             region: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             secret_id: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             secret_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             security_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetCosReferenceResult]:
+    """
+    Access state stored in a Tencent Cloud Object Storage (COS) bucket.
+
+    :param _builtins.bool accelerate: Whether to read through the global acceleration endpoint of the bucket.
+    :param Union['CosAssumeRole', 'CosAssumeRoleDict'] assume_role: A CAM role to assume in order to read the state.
+    :param _builtins.str bucket: The name of the COS bucket, in the form <name>-<appid>.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key: The name of the state file inside prefix. When using a non-default workspace, the state path is <prefix>/<workspace>/<key>.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory in the bucket holding the state file.
+    :param _builtins.str region: The region of the COS bucket. Falls back to the TENCENTCLOUD_REGION environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str secret_id: Tencent Cloud secret ID. Falls back to the TENCENTCLOUD_SECRET_ID environment variable when unset.
+    :param _builtins.str secret_key: Tencent Cloud secret key. Falls back to the TENCENTCLOUD_SECRET_KEY environment variable when unset.
+    :param _builtins.str security_token: The security token of temporary credentials. Falls back to the TENCENTCLOUD_SECURITY_TOKEN environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accelerate'] = accelerate
+    __args__['assumeRole'] = assume_role
+    __args__['bucket'] = bucket
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['key'] = key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretId'] = secret_id
+    __args__['secretKey'] = secret_key
+    __args__['securityToken'] = security_token
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getCosReference', __args__, opts=opts, typ=GetCosReferenceResult)
+    return __ret__.apply(lambda __response__: GetCosReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
@@ -0,0 +1,239 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'GetGcsReferenceResult',
+    'AwaitableGetGcsReferenceResult',
+    'get_gcs_reference',
+    'get_gcs_reference_output',
+]
+
+@pulumi.output_type
+class GetGcsReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetGcsReferenceResult(GetGcsReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetGcsReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_gcs_reference(access_token: Optional[_builtins.str] = None,
+                      bucket: Optional[_builtins.str] = None,
+                      credentials: Optional[_builtins.str] = None,
+                      encryption_key: Optional[_builtins.str] = None,
+                      env: Optional[Mapping[str, _builtins.str]] = None,
+                      impersonate_service_account: Optional[_builtins.str] = None,
+                      impersonate_service_account_delegates: Optional[Sequence[_builtins.str]] = None,
+                      include_resources: Optional[_builtins.bool] = None,
+                      kms_encryption_key: Optional[_builtins.str] = None,
+                      outputs: Optional[Sequence[_builtins.str]] = None,
+                      prefix: Optional[_builtins.str] = None,
+                      required_outputs: Optional[Sequence[_builtins.str]] = None,
+                      resources: Optional[Sequence[_builtins.str]] = None,
+                      storage_custom_endpoint: Optional[_builtins.str] = None,
+                      timeout: Optional[_builtins.str] = None,
+                      workspace: Optional[_builtins.str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetGcsReferenceResult:
+    """
+    Access state stored in a Google Cloud Storage bucket.
+
+    :param _builtins.str access_token: A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+    :param _builtins.str bucket: The name of the GCS bucket.
+    :param _builtins.str credentials: The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+    :param _builtins.str encryption_key: The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str impersonate_service_account: The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+    :param Sequence[_builtins.str] impersonate_service_account_delegates: The delegation chain for impersonating impersonateServiceAccount.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str kms_encryption_key: The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str storage_custom_endpoint: A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessToken'] = access_token
+    __args__['bucket'] = bucket
+    __args__['credentials'] = credentials
+    __args__['encryptionKey'] = encryption_key
+    __args__['env'] = env
+    __args__['impersonateServiceAccount'] = impersonate_service_account
+    __args__['impersonateServiceAccountDelegates'] = impersonate_service_account_delegates
+    __args__['includeResources'] = include_resources
+    __args__['kmsEncryptionKey'] = kms_encryption_key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['storageCustomEndpoint'] = storage_custom_endpoint
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getGcsReference', __args__, opts=opts, typ=GetGcsReferenceResult).value
+
+    return AwaitableGetGcsReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_gcs_reference_output(access_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             bucket: pulumi.Input[Optional[_builtins.str]] = None,
+                             credentials: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             encryption_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                             impersonate_service_account: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             impersonate_service_account_delegates: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                             kms_encryption_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             storage_custom_endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetGcsReferenceResult]:
+    """
+    Access state stored in a Google Cloud Storage bucket.
+
+    :param _builtins.str access_token: A temporary OAuth 2.0 access token. Takes precedence over credentials. Falls back to the GOOGLE_OAUTH_ACCESS_TOKEN environment variable when unset.
+    :param _builtins.str bucket: The name of the GCS bucket.
+    :param _builtins.str credentials: The path to, or the contents of, a Google Cloud service account key file in JSON format. Falls back to the GOOGLE_BACKEND_CREDENTIALS or GOOGLE_CREDENTIALS environment variables, and then to Application Default Credentials, when unset.
+    :param _builtins.str encryption_key: The base64-encoded customer-supplied encryption key the state was written with. Conflicts with kmsEncryptionKey. Falls back to the GOOGLE_ENCRYPTION_KEY environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str impersonate_service_account: The service account to impersonate when reading the state. Falls back to the GOOGLE_BACKEND_IMPERSONATE_SERVICE_ACCOUNT or GOOGLE_IMPERSONATE_SERVICE_ACCOUNT environment variables when unset.
+    :param Sequence[_builtins.str] impersonate_service_account_delegates: The delegation chain for impersonating impersonateServiceAccount.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str kms_encryption_key: The Cloud KMS key the state was written with, in the form projects/{project}/locations/{location}/keyRings/{keyRing}/cryptoKeys/{name}. Conflicts with encryptionKey. Falls back to the GOOGLE_KMS_ENCRYPTION_KEY environment variable when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory inside the bucket holding the state files. The state of a workspace is stored at <prefix>/<workspace>.tfstate.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str storage_custom_endpoint: A custom endpoint for the Cloud Storage API. Falls back to the GOOGLE_BACKEND_STORAGE_CUSTOM_ENDPOINT or GOOGLE_STORAGE_CUSTOM_ENDPOINT environment variables when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessToken'] = access_token
+    __args__['bucket'] = bucket
+    __args__['credentials'] = credentials
+    __args__['encryptionKey'] = encryption_key
+    __args__['env'] = env
+    __args__['impersonateServiceAccount'] = impersonate_service_account
+    __args__['impersonateServiceAccountDelegates'] = impersonate_service_account_delegates
+    __args__['includeResources'] = include_resources
+    __args__['kmsEncryptionKey'] = kms_encryption_key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['storageCustomEndpoint'] = storage_custom_endpoint
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getGcsReference', __args__, opts=opts, typ=GetGcsReferenceResult)
+    return __ret__.apply(lambda __response__: GetGcsReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
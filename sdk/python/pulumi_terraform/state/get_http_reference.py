@@ -0,0 +1,239 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'GetHttpReferenceResult',
+    'AwaitableGetHttpReferenceResult',
+    'get_http_reference',
+    'get_http_reference_output',
+]
+
+@pulumi.output_type
+class GetHttpReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetHttpReferenceResult(GetHttpReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetHttpReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_http_reference(address: Optional[_builtins.str] = None,
+                       client_ca_certificate_pem: Optional[_builtins.str] = None,
+                       client_certificate_pem: Optional[_builtins.str] = None,
+                       client_private_key_pem: Optional[_builtins.str] = None,
+                       env: Optional[Mapping[str, _builtins.str]] = None,
+                       include_resources: Optional[_builtins.bool] = None,
+                       outputs: Optional[Sequence[_builtins.str]] = None,
+                       password: Optional[_builtins.str] = None,
+                       required_outputs: Optional[Sequence[_builtins.str]] = None,
+                       resources: Optional[Sequence[_builtins.str]] = None,
+                       retry_max: Optional[_builtins.int] = None,
+                       retry_wait_max: Optional[_builtins.int] = None,
+                       retry_wait_min: Optional[_builtins.int] = None,
+                       skip_cert_verification: Optional[_builtins.bool] = None,
+                       timeout: Optional[_builtins.str] = None,
+                       username: Optional[_builtins.str] = None,
+                       opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetHttpReferenceResult:
+    """
+    Access state served by a REST endpoint through the http backend.
+
+    :param _builtins.str address: The address of the REST endpoint serving the state.
+    :param _builtins.str client_ca_certificate_pem: A PEM-encoded CA certificate chain used to verify the server certificate.
+    :param _builtins.str client_certificate_pem: A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+    :param _builtins.str client_private_key_pem: The PEM-encoded private key of clientCertificatePem.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str password: The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.int retry_max: The number of HTTP request retries. Defaults to 2.
+    :param _builtins.int retry_wait_max: The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+    :param _builtins.int retry_wait_min: The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+    :param _builtins.bool skip_cert_verification: Whether to skip verification of the server's TLS certificate.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str username: The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+    """
+    __args__ = dict()
+    __args__['address'] = address
+    __args__['clientCaCertificatePem'] = client_ca_certificate_pem
+    __args__['clientCertificatePem'] = client_certificate_pem
+    __args__['clientPrivateKeyPem'] = client_private_key_pem
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['outputs'] = outputs
+    __args__['password'] = password
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['retryMax'] = retry_max
+    __args__['retryWaitMax'] = retry_wait_max
+    __args__['retryWaitMin'] = retry_wait_min
+    __args__['skipCertVerification'] = skip_cert_verification
+    __args__['timeout'] = timeout
+    __args__['username'] = username
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getHttpReference', __args__, opts=opts, typ=GetHttpReferenceResult).value
+
+    return AwaitableGetHttpReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_http_reference_output(address: pulumi.Input[Optional[_builtins.str]] = None,
+                              client_ca_certificate_pem: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              client_certificate_pem: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              client_private_key_pem: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                              include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                              outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                              password: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                              resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                              retry_max: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                              retry_wait_max: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                              retry_wait_min: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                              skip_cert_verification: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                              timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              username: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                              opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetHttpReferenceResult]:
+    """
+    Access state served by a REST endpoint through the http backend.
+
+    :param _builtins.str address: The address of the REST endpoint serving the state.
+    :param _builtins.str client_ca_certificate_pem: A PEM-encoded CA certificate chain used to verify the server certificate.
+    :param _builtins.str client_certificate_pem: A PEM-encoded certificate presented to the server for mutual TLS authentication. Requires clientPrivateKeyPem.
+    :param _builtins.str client_private_key_pem: The PEM-encoded private key of clientCertificatePem.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str password: The password for HTTP basic authentication. Falls back to the TF_HTTP_PASSWORD environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.int retry_max: The number of HTTP request retries. Defaults to 2.
+    :param _builtins.int retry_wait_max: The maximum time in seconds to wait between HTTP request attempts. Defaults to 30.
+    :param _builtins.int retry_wait_min: The minimum time in seconds to wait between HTTP request attempts. Defaults to 1.
+    :param _builtins.bool skip_cert_verification: Whether to skip verification of the server's TLS certificate.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str username: The username for HTTP basic authentication. Falls back to the TF_HTTP_USERNAME environment variable when unset.
+    """
+    __args__ = dict()
+    __args__['address'] = address
+    __args__['clientCaCertificatePem'] = client_ca_certificate_pem
+    __args__['clientCertificatePem'] = client_certificate_pem
+    __args__['clientPrivateKeyPem'] = client_private_key_pem
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['outputs'] = outputs
+    __args__['password'] = password
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['retryMax'] = retry_max
+    __args__['retryWaitMax'] = retry_wait_max
+    __args__['retryWaitMin'] = retry_wait_min
+    __args__['skipCertVerification'] = skip_cert_verification
+    __args__['timeout'] = timeout
+    __args__['username'] = username
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getHttpReference', __args__, opts=opts, typ=GetHttpReferenceResult)
+    return __ret__.apply(lambda __response__: GetHttpReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
@@ -0,0 +1,276 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetKubernetesReferenceResult',
+    'AwaitableGetKubernetesReferenceResult',
+    'get_kubernetes_reference',
+    'get_kubernetes_reference_output',
+]
+
+@pulumi.output_type
+class GetKubernetesReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetKubernetesReferenceResult(GetKubernetesReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetKubernetesReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_kubernetes_reference(client_certificate: Optional[_builtins.str] = None,
+                             client_key: Optional[_builtins.str] = None,
+                             cluster_ca_certificate: Optional[_builtins.str] = None,
+                             config_context: Optional[_builtins.str] = None,
+                             config_context_auth_info: Optional[_builtins.str] = None,
+                             config_context_cluster: Optional[_builtins.str] = None,
+                             config_path: Optional[_builtins.str] = None,
+                             config_paths: Optional[Sequence[_builtins.str]] = None,
+                             env: Optional[Mapping[str, _builtins.str]] = None,
+                             exec_: Optional[Union['KubernetesExec', 'KubernetesExecDict']] = None,
+                             host: Optional[_builtins.str] = None,
+                             in_cluster_config: Optional[_builtins.bool] = None,
+                             include_resources: Optional[_builtins.bool] = None,
+                             insecure: Optional[_builtins.bool] = None,
+                             namespace: Optional[_builtins.str] = None,
+                             outputs: Optional[Sequence[_builtins.str]] = None,
+                             required_outputs: Optional[Sequence[_builtins.str]] = None,
+                             resources: Optional[Sequence[_builtins.str]] = None,
+                             secret_suffix: Optional[_builtins.str] = None,
+                             timeout: Optional[_builtins.str] = None,
+                             token: Optional[_builtins.str] = None,
+                             workspace: Optional[_builtins.str] = None,
+                             opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetKubernetesReferenceResult:
+    """
+    Access state stored in a Kubernetes secret.
+
+    :param _builtins.str client_certificate: The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+    :param _builtins.str client_key: The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+    :param _builtins.str cluster_ca_certificate: The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+    :param _builtins.str config_context: The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+    :param _builtins.str config_context_auth_info: The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+    :param _builtins.str config_context_cluster: The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+    :param _builtins.str config_path: The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+    :param Sequence[_builtins.str] config_paths: A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param Union['KubernetesExec', 'KubernetesExecDict'] exec_: A credential plugin used to obtain credentials for the API server.
+    :param _builtins.str host: The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+    :param _builtins.bool in_cluster_config: Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.bool insecure: Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+    :param _builtins.str namespace: The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str secret_suffix: The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str token: A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['clientCertificate'] = client_certificate
+    __args__['clientKey'] = client_key
+    __args__['clusterCaCertificate'] = cluster_ca_certificate
+    __args__['configContext'] = config_context
+    __args__['configContextAuthInfo'] = config_context_auth_info
+    __args__['configContextCluster'] = config_context_cluster
+    __args__['configPath'] = config_path
+    __args__['configPaths'] = config_paths
+    __args__['env'] = env
+    __args__['exec'] = exec_
+    __args__['host'] = host
+    __args__['inClusterConfig'] = in_cluster_config
+    __args__['includeResources'] = include_resources
+    __args__['insecure'] = insecure
+    __args__['namespace'] = namespace
+    __args__['outputs'] = outputs
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretSuffix'] = secret_suffix
+    __args__['timeout'] = timeout
+    __args__['token'] = token
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getKubernetesReference', __args__, opts=opts, typ=GetKubernetesReferenceResult).value
+
+    return AwaitableGetKubernetesReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_kubernetes_reference_output(client_certificate: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    client_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    cluster_ca_certificate: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    config_context: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    config_context_auth_info: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    config_context_cluster: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    config_path: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    config_paths: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                                    exec_: pulumi.Input[Optional[Optional[Union['KubernetesExec', 'KubernetesExecDict']]]] = None,
+                                    host: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    in_cluster_config: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                                    include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                                    insecure: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                                    namespace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    secret_suffix: pulumi.Input[Optional[_builtins.str]] = None,
+                                    timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetKubernetesReferenceResult]:
+    """
+    Access state stored in a Kubernetes secret.
+
+    :param _builtins.str client_certificate: The PEM-encoded client certificate for TLS authentication. Falls back to the KUBE_CLIENT_CERT_DATA environment variable when unset.
+    :param _builtins.str client_key: The PEM-encoded private key of clientCertificate. Falls back to the KUBE_CLIENT_KEY_DATA environment variable when unset.
+    :param _builtins.str cluster_ca_certificate: The PEM-encoded root certificate bundle of the API server. Falls back to the KUBE_CLUSTER_CA_CERT_DATA environment variable when unset.
+    :param _builtins.str config_context: The kubeconfig context to use. Falls back to the KUBE_CTX environment variable when unset.
+    :param _builtins.str config_context_auth_info: The kubeconfig user to use. Falls back to the KUBE_CTX_AUTH_INFO environment variable when unset.
+    :param _builtins.str config_context_cluster: The kubeconfig cluster to use. Falls back to the KUBE_CTX_CLUSTER environment variable when unset.
+    :param _builtins.str config_path: The path to the kubeconfig file. Falls back to the KUBE_CONFIG_PATH environment variable when unset.
+    :param Sequence[_builtins.str] config_paths: A list of paths to kubeconfig files. Ignored when configPath is set. Falls back to the KUBE_CONFIG_PATHS environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param Union['KubernetesExec', 'KubernetesExecDict'] exec_: A credential plugin used to obtain credentials for the API server.
+    :param _builtins.str host: The URL of the Kubernetes API server, overriding the one from the kubeconfig. The backend still requires a kubeconfig file or inClusterConfig. Falls back to the KUBE_HOST environment variable when unset.
+    :param _builtins.bool in_cluster_config: Whether to authenticate with the service account of the pod the provider runs in. Falls back to the KUBE_IN_CLUSTER_CONFIG environment variable when unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.bool insecure: Whether to skip verification of the API server's TLS certificate. Falls back to the KUBE_INSECURE environment variable when unset.
+    :param _builtins.str namespace: The namespace of the secret holding the state. Falls back to the KUBE_NAMESPACE environment variable, and then to default, when unset.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str secret_suffix: The suffix of the secret holding the state. The secret is named tfstate-<workspace>-<secretSuffix>.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str token: A bearer token, e.g. of a service account. Falls back to the KUBE_TOKEN environment variable when unset.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['clientCertificate'] = client_certificate
+    __args__['clientKey'] = client_key
+    __args__['clusterCaCertificate'] = cluster_ca_certificate
+    __args__['configContext'] = config_context
+    __args__['configContextAuthInfo'] = config_context_auth_info
+    __args__['configContextCluster'] = config_context_cluster
+    __args__['configPath'] = config_path
+    __args__['configPaths'] = config_paths
+    __args__['env'] = env
+    __args__['exec'] = exec_
+    __args__['host'] = host
+    __args__['inClusterConfig'] = in_cluster_config
+    __args__['includeResources'] = include_resources
+    __args__['insecure'] = insecure
+    __args__['namespace'] = namespace
+    __args__['outputs'] = outputs
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretSuffix'] = secret_suffix
+    __args__['timeout'] = timeout
+    __args__['token'] = token
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getKubernetesReference', __args__, opts=opts, typ=GetKubernetesReferenceResult)
+    return __ret__.apply(lambda __response__: GetKubernetesReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
@@ -0,0 +1,281 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'GetOssReferenceResult',
+    'AwaitableGetOssReferenceResult',
+    'get_oss_reference',
+    'get_oss_reference_output',
+]
+
+@pulumi.output_type
+class GetOssReferenceResult:
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
+        if outputs and not isinstance(outputs, dict):
+            raise TypeError("Expected argument 'outputs' to be a dict")
+        pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+class AwaitableGetOssReferenceResult(GetOssReferenceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetOssReferenceResult(
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
+
+
+def get_oss_reference(access_key: Optional[_builtins.str] = None,
+                      assume_role_policy: Optional[_builtins.str] = None,
+                      assume_role_session_expiration: Optional[_builtins.int] = None,
+                      bucket: Optional[_builtins.str] = None,
+                      ecs_role_name: Optional[_builtins.str] = None,
+                      endpoint: Optional[_builtins.str] = None,
+                      env: Optional[Mapping[str, _builtins.str]] = None,
+                      include_resources: Optional[_builtins.bool] = None,
+                      key: Optional[_builtins.str] = None,
+                      outputs: Optional[Sequence[_builtins.str]] = None,
+                      prefix: Optional[_builtins.str] = None,
+                      profile: Optional[_builtins.str] = None,
+                      region: Optional[_builtins.str] = None,
+                      required_outputs: Optional[Sequence[_builtins.str]] = None,
+                      resources: Optional[Sequence[_builtins.str]] = None,
+                      role_arn: Optional[_builtins.str] = None,
+                      secret_key: Optional[_builtins.str] = None,
+                      security_token: Optional[_builtins.str] = None,
+                      session_name: Optional[_builtins.str] = None,
+                      shared_credentials_file: Optional[_builtins.str] = None,
+                      sts_endpoint: Optional[_builtins.str] = None,
+                      timeout: Optional[_builtins.str] = None,
+                      workspace: Optional[_builtins.str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetOssReferenceResult:
+    """
+    Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.
+
+    :param _builtins.str access_key: Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+    :param _builtins.str assume_role_policy: A policy further restricting the permissions of the assumed role.
+    :param _builtins.int assume_role_session_expiration: The duration, in seconds, of the assume role session, between 900 and 3600.
+    :param _builtins.str bucket: The name of the OSS bucket.
+    :param _builtins.str ecs_role_name: The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+    :param _builtins.str endpoint: A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key: The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory in the bucket holding the state. Defaults to env:.
+    :param _builtins.str profile: The profile name as set in the shared credentials file.
+    :param _builtins.str region: The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str role_arn: The ARN of a RAM role to be assumed in order to read the state.
+    :param _builtins.str secret_key: Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+    :param _builtins.str security_token: The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+    :param _builtins.str session_name: The session name to use when assuming the role.
+    :param _builtins.str shared_credentials_file: Path to a shared credentials file.
+    :param _builtins.str sts_endpoint: A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessKey'] = access_key
+    __args__['assumeRolePolicy'] = assume_role_policy
+    __args__['assumeRoleSessionExpiration'] = assume_role_session_expiration
+    __args__['bucket'] = bucket
+    __args__['ecsRoleName'] = ecs_role_name
+    __args__['endpoint'] = endpoint
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['key'] = key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['profile'] = profile
+    __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['roleArn'] = role_arn
+    __args__['secretKey'] = secret_key
+    __args__['securityToken'] = security_token
+    __args__['sessionName'] = session_name
+    __args__['sharedCredentialsFile'] = shared_credentials_file
+    __args__['stsEndpoint'] = sts_endpoint
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getOssReference', __args__, opts=opts, typ=GetOssReferenceResult).value
+
+    return AwaitableGetOssReferenceResult(
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
+def get_oss_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             assume_role_policy: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             assume_role_session_expiration: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                             bucket: pulumi.Input[Optional[_builtins.str]] = None,
+                             ecs_role_name: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                             include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                             key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             profile: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             region: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                             role_arn: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             secret_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             security_token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             session_name: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             shared_credentials_file: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             sts_endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetOssReferenceResult]:
+    """
+    Access state stored in an Alibaba Cloud Object Storage Service (OSS) bucket.
+
+    :param _builtins.str access_key: Alibaba Cloud access key. Falls back to the ALICLOUD_ACCESS_KEY environment variable when unset.
+    :param _builtins.str assume_role_policy: A policy further restricting the permissions of the assumed role.
+    :param _builtins.int assume_role_session_expiration: The duration, in seconds, of the assume role session, between 900 and 3600.
+    :param _builtins.str bucket: The name of the OSS bucket.
+    :param _builtins.str ecs_role_name: The RAM role attached to the ECS instance to read credentials from, used when no access key is configured.
+    :param _builtins.str endpoint: A custom endpoint for the OSS API. Falls back to the ALICLOUD_OSS_ENDPOINT environment variable when unset.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param _builtins.str key: The name of the state file. The state path is <prefix>/<key> for the default workspace and <prefix>/<workspace>/<key> for the others. Defaults to terraform.tfstate.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.str prefix: The directory in the bucket holding the state. Defaults to env:.
+    :param _builtins.str profile: The profile name as set in the shared credentials file.
+    :param _builtins.str region: The region of the OSS bucket. Falls back to the ALICLOUD_REGION environment variable when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param _builtins.str role_arn: The ARN of a RAM role to be assumed in order to read the state.
+    :param _builtins.str secret_key: Alibaba Cloud secret key. Falls back to the ALICLOUD_SECRET_KEY environment variable when unset.
+    :param _builtins.str security_token: The security token of temporary credentials. Falls back to the ALICLOUD_SECURITY_TOKEN environment variable when unset.
+    :param _builtins.str session_name: The session name to use when assuming the role.
+    :param _builtins.str shared_credentials_file: Path to a shared credentials file.
+    :param _builtins.str sts_endpoint: A custom endpoint for the STS API. Falls back to the ALICLOUD_STS_ENDPOINT environment variable when unset.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['accessKey'] = access_key
+    __args__['assumeRolePolicy'] = assume_role_policy
+    __args__['assumeRoleSessionExpiration'] = assume_role_session_expiration
+    __args__['bucket'] = bucket
+    __args__['ecsRoleName'] = ecs_role_name
+    __args__['endpoint'] = endpoint
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['key'] = key
+    __args__['outputs'] = outputs
+    __args__['prefix'] = prefix
+    __args__['profile'] = profile
+    __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['roleArn'] = role_arn
+    __args__['secretKey'] = secret_key
+    __args__['securityToken'] = security_token
+    __args__['sessionName'] = session_name
+    __args__['sharedCredentialsFile'] = shared_credentials_file
+    __args__['stsEndpoint'] = sts_endpoint
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getOssReference', __args__, opts=opts, typ=GetOssReferenceResult)
+    return __ret__.apply(lambda __response__: GetOssReferenceResult(
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
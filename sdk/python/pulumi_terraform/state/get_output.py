@@ -0,0 +1,117 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+
+__all__ = [
+    'GetOutputResult',
+    'AwaitableGetOutputResult',
+    'get_output',
+    'get_output_output',
+]
+
+@pulumi.output_type
+class GetOutputResult:
+    def __init__(__self__, value=None):
+        if value and not isinstance(value, dict):
+            raise TypeError("Expected argument 'value' to be a dict")
+        pulumi.set(__self__, "value", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def value(self) -> Optional[Any]:
+        """
+        The value of the output. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "value")
+
+
+class AwaitableGetOutputResult(GetOutputResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetOutputResult(
+            value=self.value)
+
+
+def get_output(backend_type: Optional[_builtins.str] = None,
+               config: Optional[Mapping[str, Any]] = None,
+               default: Optional[Any] = None,
+               env: Optional[Mapping[str, _builtins.str]] = None,
+               name: Optional[_builtins.str] = None,
+               secret_config: Optional[Mapping[str, Any]] = None,
+               timeout: Optional[_builtins.str] = None,
+               workspace: Optional[_builtins.str] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetOutputResult:
+    """
+    Read a single output from Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference. Outputs Terraform marks as sensitive are returned as secrets.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Any default: The value to return when the state has no output named name. When unset, a missing output is an error.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str name: The name of the output to read.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['default'] = default
+    __args__['env'] = env
+    __args__['name'] = name
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getOutput', __args__, opts=opts, typ=GetOutputResult).value
+
+    return AwaitableGetOutputResult(
+        value=pulumi.get(__ret__, 'value'))
+def get_output_output(backend_type: pulumi.Input[Optional[_builtins.str]] = None,
+                      config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                      default: pulumi.Input[Optional[Optional[Any]]] = None,
+                      env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                      name: pulumi.Input[Optional[_builtins.str]] = None,
+                      secret_config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                      timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                      workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                      opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetOutputResult]:
+    """
+    Read a single output from Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference. Outputs Terraform marks as sensitive are returned as secrets.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Any default: The value to return when the state has no output named name. When unset, a missing output is an error.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str name: The name of the output to read.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param _builtins.str workspace: The Terraform workspace to read state from.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['default'] = default
+    __args__['env'] = env
+    __args__['name'] = name
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    __args__['workspace'] = workspace
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getOutput', __args__, opts=opts, typ=GetOutputResult)
+    return __ret__.apply(lambda __response__: GetOutputResult(
+        value=pulumi.get(__response__, 'value')))
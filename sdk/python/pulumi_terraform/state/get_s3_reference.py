@@ -13,6 +13,7 @@ if sys.version_info >= (3, 11):
 else:
     from typing_extensions import NotRequired, TypedDict, TypeAlias
 from .. import _utilities
+from . import outputs
 
 __all__ = [
     'GetS3ReferenceResult',
@@ -26,19 +27,74 @@ class GetS3ReferenceResult:
     """
     The result of fetching from a Terraform state store.
     """
-    def __init__(__self__, outputs=None):
+    def __init__(__self__, lineage=None, outputs=None, resources=None, serial=None, state_format_version=None, terraform_version=None):
+        if lineage and not isinstance(lineage, str):
+            raise TypeError("Expected argument 'lineage' to be a str")
+        pulumi.set(__self__, "lineage", lineage)
         if outputs and not isinstance(outputs, dict):
             raise TypeError("Expected argument 'outputs' to be a dict")
         pulumi.set(__self__, "outputs", outputs)
+        if resources and not isinstance(resources, list):
+            raise TypeError("Expected argument 'resources' to be a list")
+        pulumi.set(__self__, "resources", resources)
+        if serial and not isinstance(serial, int):
+            raise TypeError("Expected argument 'serial' to be a int")
+        pulumi.set(__self__, "serial", serial)
+        if state_format_version and not isinstance(state_format_version, int):
+            raise TypeError("Expected argument 'state_format_version' to be a int")
+        pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version and not isinstance(terraform_version, str):
+            raise TypeError("Expected argument 'terraform_version' to be a str")
+        pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
 
     @_builtins.property
     @pulumi.getter
     def outputs(self) -> Mapping[str, Any]:
         """
-        The outputs displayed from Terraform state.
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
         """
         return pulumi.get(self, "outputs")
 
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
 
 class AwaitableGetS3ReferenceResult(GetS3ReferenceResult):
     # pylint: disable=using-constant-test
@@ -46,7 +102,12 @@ class AwaitableGetS3ReferenceResult(GetS3ReferenceResult):
         if False:
             yield self
         return GetS3ReferenceResult(
-            outputs=self.outputs)
+            lineage=self.lineage,
+            outputs=self.outputs,
+            resources=self.resources,
+            serial=self.serial,
+            state_format_version=self.state_format_version,
+            terraform_version=self.terraform_version)
 
 
 def get_s3_reference(access_key: Optional[_builtins.str] = None,
@@ -58,14 +119,19 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
                      bucket: Optional[_builtins.str] = None,
                      encrypt: Optional[_builtins.bool] = None,
                      endpoint: Optional[_builtins.str] = None,
+                     env: Optional[Mapping[str, _builtins.str]] = None,
                      external_id: Optional[_builtins.str] = None,
                      force_path_style: Optional[_builtins.bool] = None,
                      iam_endpoint: Optional[_builtins.str] = None,
+                     include_resources: Optional[_builtins.bool] = None,
                      key: Optional[_builtins.str] = None,
                      kms_key_id: Optional[_builtins.str] = None,
                      max_retries: Optional[_builtins.int] = None,
+                     outputs: Optional[Sequence[_builtins.str]] = None,
                      profile: Optional[_builtins.str] = None,
                      region: Optional[_builtins.str] = None,
+                     required_outputs: Optional[Sequence[_builtins.str]] = None,
+                     resources: Optional[Sequence[_builtins.str]] = None,
                      role_arn: Optional[_builtins.str] = None,
                      secret_key: Optional[_builtins.str] = None,
                      session_name: Optional[_builtins.str] = None,
@@ -75,6 +141,7 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
                      skip_region_validation: Optional[_builtins.bool] = None,
                      sse_customer_key: Optional[_builtins.str] = None,
                      sts_endpoint: Optional[_builtins.str] = None,
+                     timeout: Optional[_builtins.str] = None,
                      token: Optional[_builtins.str] = None,
                      workspace: Optional[_builtins.str] = None,
                      workspace_key_prefix: Optional[_builtins.str] = None,
@@ -91,14 +158,19 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
     :param _builtins.str bucket: The name of the S3 bucket.
     :param _builtins.bool encrypt: Whether to enable server side encryption of the state file.
     :param _builtins.str endpoint: A custom endpoint for the S3 API.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
     :param _builtins.str external_id: The external ID to use when assuming the role.
     :param _builtins.bool force_path_style: Force s3 to use path-style addressing instead of virtual hosted-bucket addressing. Required by most S3-compatible stores.
     :param _builtins.str iam_endpoint: A custom endpoint for the IAM API.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
     :param _builtins.str key: The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
     :param _builtins.str kms_key_id: The ARN of a KMS Key to use for encrypting the state.
     :param _builtins.int max_retries: The maximum number of times an AWS API request is retried on retryable failure.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
     :param _builtins.str profile: AWS profile name as set in the shared credentials file.
     :param _builtins.str region: AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
     :param _builtins.str role_arn: The ARN of an IAM Role to be assumed in order to read the state.
     :param _builtins.str secret_key: AWS secret key.
     :param _builtins.str session_name: The session name to use when assuming the role.
@@ -108,6 +180,7 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
     :param _builtins.bool skip_region_validation: Skip static validation of region name.
     :param _builtins.str sse_customer_key: The base64-encoded encryption key to use for server-side encryption with customer-provided keys (SSE-C).
     :param _builtins.str sts_endpoint: A custom endpoint for the STS API.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
     :param _builtins.str token: AWS session token.
     :param _builtins.str workspace: The Terraform workspace to read state from.
     :param _builtins.str workspace_key_prefix: The prefix applied to the non-default state path inside the bucket.
@@ -122,14 +195,19 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
     __args__['bucket'] = bucket
     __args__['encrypt'] = encrypt
     __args__['endpoint'] = endpoint
+    __args__['env'] = env
     __args__['externalId'] = external_id
     __args__['forcePathStyle'] = force_path_style
     __args__['iamEndpoint'] = iam_endpoint
+    __args__['includeResources'] = include_resources
     __args__['key'] = key
     __args__['kmsKeyId'] = kms_key_id
     __args__['maxRetries'] = max_retries
+    __args__['outputs'] = outputs
     __args__['profile'] = profile
     __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
     __args__['roleArn'] = role_arn
     __args__['secretKey'] = secret_key
     __args__['sessionName'] = session_name
@@ -139,6 +217,7 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
     __args__['skipRegionValidation'] = skip_region_validation
     __args__['sseCustomerKey'] = sse_customer_key
     __args__['stsEndpoint'] = sts_endpoint
+    __args__['timeout'] = timeout
     __args__['token'] = token
     __args__['workspace'] = workspace
     __args__['workspaceKeyPrefix'] = workspace_key_prefix
@@ -146,7 +225,12 @@ def get_s3_reference(access_key: Optional[_builtins.str] = None,
     __ret__ = pulumi.runtime.invoke('terraform:state:getS3Reference', __args__, opts=opts, typ=GetS3ReferenceResult).value
 
     return AwaitableGetS3ReferenceResult(
-        outputs=pulumi.get(__ret__, 'outputs'))
+        lineage=pulumi.get(__ret__, 'lineage'),
+        outputs=pulumi.get(__ret__, 'outputs'),
+        resources=pulumi.get(__ret__, 'resources'),
+        serial=pulumi.get(__ret__, 'serial'),
+        state_format_version=pulumi.get(__ret__, 'state_format_version'),
+        terraform_version=pulumi.get(__ret__, 'terraform_version'))
 def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             assume_role_duration_seconds: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
                             assume_role_policy: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
@@ -156,14 +240,19 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
                             bucket: pulumi.Input[Optional[_builtins.str]] = None,
                             encrypt: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                             endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                            env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
                             external_id: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             force_path_style: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                             iam_endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                            include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                             key: pulumi.Input[Optional[_builtins.str]] = None,
                             kms_key_id: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             max_retries: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                            outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                             profile: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             region: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                            required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                            resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
                             role_arn: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             secret_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             session_name: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
@@ -173,6 +262,7 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
                             skip_region_validation: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
                             sse_customer_key: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             sts_endpoint: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                            timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             token: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             workspace: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
                             workspace_key_prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
@@ -189,14 +279,19 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
     :param _builtins.str bucket: The name of the S3 bucket.
     :param _builtins.bool encrypt: Whether to enable server side encryption of the state file.
     :param _builtins.str endpoint: A custom endpoint for the S3 API.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
     :param _builtins.str external_id: The external ID to use when assuming the role.
     :param _builtins.bool force_path_style: Force s3 to use path-style addressing instead of virtual hosted-bucket addressing. Required by most S3-compatible stores.
     :param _builtins.str iam_endpoint: A custom endpoint for the IAM API.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
     :param _builtins.str key: The path to the state file inside the bucket. When using a non-default workspace, the state path is /workspace_key_prefix/workspace_name/key.
     :param _builtins.str kms_key_id: The ARN of a KMS Key to use for encrypting the state.
     :param _builtins.int max_retries: The maximum number of times an AWS API request is retried on retryable failure.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
     :param _builtins.str profile: AWS profile name as set in the shared credentials file.
     :param _builtins.str region: AWS region of the S3 bucket. Falls back to the AWS_REGION or AWS_DEFAULT_REGION environment variables when unset.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
     :param _builtins.str role_arn: The ARN of an IAM Role to be assumed in order to read the state.
     :param _builtins.str secret_key: AWS secret key.
     :param _builtins.str session_name: The session name to use when assuming the role.
@@ -206,6 +301,7 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
     :param _builtins.bool skip_region_validation: Skip static validation of region name.
     :param _builtins.str sse_customer_key: The base64-encoded encryption key to use for server-side encryption with customer-provided keys (SSE-C).
     :param _builtins.str sts_endpoint: A custom endpoint for the STS API.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
     :param _builtins.str token: AWS session token.
     :param _builtins.str workspace: The Terraform workspace to read state from.
     :param _builtins.str workspace_key_prefix: The prefix applied to the non-default state path inside the bucket.
@@ -220,14 +316,19 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
     __args__['bucket'] = bucket
     __args__['encrypt'] = encrypt
     __args__['endpoint'] = endpoint
+    __args__['env'] = env
     __args__['externalId'] = external_id
     __args__['forcePathStyle'] = force_path_style
     __args__['iamEndpoint'] = iam_endpoint
+    __args__['includeResources'] = include_resources
     __args__['key'] = key
     __args__['kmsKeyId'] = kms_key_id
     __args__['maxRetries'] = max_retries
+    __args__['outputs'] = outputs
     __args__['profile'] = profile
     __args__['region'] = region
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
     __args__['roleArn'] = role_arn
     __args__['secretKey'] = secret_key
     __args__['sessionName'] = session_name
@@ -237,10 +338,16 @@ def get_s3_reference_output(access_key: pulumi.Input[Optional[Optional[_builtins
     __args__['skipRegionValidation'] = skip_region_validation
     __args__['sseCustomerKey'] = sse_customer_key
     __args__['stsEndpoint'] = sts_endpoint
+    __args__['timeout'] = timeout
     __args__['token'] = token
     __args__['workspace'] = workspace
     __args__['workspaceKeyPrefix'] = workspace_key_prefix
     opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
     __ret__ = pulumi.runtime.invoke_output('terraform:state:getS3Reference', __args__, opts=opts, typ=GetS3ReferenceResult)
     return __ret__.apply(lambda __response__: GetS3ReferenceResult(
-        outputs=pulumi.get(__response__, 'outputs')))
+        lineage=pulumi.get(__response__, 'lineage'),
+        outputs=pulumi.get(__response__, 'outputs'),
+        resources=pulumi.get(__response__, 'resources'),
+        serial=pulumi.get(__response__, 'serial'),
+        state_format_version=pulumi.get(__response__, 'state_format_version'),
+        terraform_version=pulumi.get(__response__, 'terraform_version')))
@@ -0,0 +1,162 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'GetWorkspaceReferencesResult',
+    'AwaitableGetWorkspaceReferencesResult',
+    'get_workspace_references',
+    'get_workspace_references_output',
+]
+
+@pulumi.output_type
+class GetWorkspaceReferencesResult:
+    def __init__(__self__, errors=None, workspaces=None):
+        if errors and not isinstance(errors, dict):
+            raise TypeError("Expected argument 'errors' to be a dict")
+        pulumi.set(__self__, "errors", errors)
+        if workspaces and not isinstance(workspaces, dict):
+            raise TypeError("Expected argument 'workspaces' to be a dict")
+        pulumi.set(__self__, "workspaces", workspaces)
+
+    @_builtins.property
+    @pulumi.getter
+    def errors(self) -> Mapping[str, _builtins.str]:
+        """
+        The error reading each workspace that could not be read, keyed by workspace name.
+        """
+        return pulumi.get(self, "errors")
+
+    @_builtins.property
+    @pulumi.getter
+    def workspaces(self) -> Mapping[str, 'outputs.StateReferenceOutputs']:
+        """
+        The state of each workspace read successfully, keyed by workspace name.
+        """
+        return pulumi.get(self, "workspaces")
+
+
+class AwaitableGetWorkspaceReferencesResult(GetWorkspaceReferencesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetWorkspaceReferencesResult(
+            errors=self.errors,
+            workspaces=self.workspaces)
+
+
+def get_workspace_references(backend_type: Optional[_builtins.str] = None,
+                             config: Optional[Mapping[str, Any]] = None,
+                             env: Optional[Mapping[str, _builtins.str]] = None,
+                             include_resources: Optional[_builtins.bool] = None,
+                             outputs: Optional[Sequence[_builtins.str]] = None,
+                             parallelism: Optional[_builtins.int] = None,
+                             prefix: Optional[_builtins.str] = None,
+                             regex: Optional[_builtins.str] = None,
+                             required_outputs: Optional[Sequence[_builtins.str]] = None,
+                             resources: Optional[Sequence[_builtins.str]] = None,
+                             secret_config: Optional[Mapping[str, Any]] = None,
+                             timeout: Optional[_builtins.str] = None,
+                             workspaces: Optional[Sequence[_builtins.str]] = None,
+                             opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetWorkspaceReferencesResult:
+    """
+    Access the state of several workspaces of any backend Terraform supports, configured as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.int parallelism: The most workspaces read at the same time.
+    :param _builtins.str prefix: Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+    :param _builtins.str regex: Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param Sequence[_builtins.str] workspaces: The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['outputs'] = outputs
+    __args__['parallelism'] = parallelism
+    __args__['prefix'] = prefix
+    __args__['regex'] = regex
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    __args__['workspaces'] = workspaces
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:getWorkspaceReferences', __args__, opts=opts, typ=GetWorkspaceReferencesResult).value
+
+    return AwaitableGetWorkspaceReferencesResult(
+        errors=pulumi.get(__ret__, 'errors'),
+        workspaces=pulumi.get(__ret__, 'workspaces'))
+def get_workspace_references_output(backend_type: pulumi.Input[Optional[_builtins.str]] = None,
+                                    config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                                    env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                                    include_resources: pulumi.Input[Optional[Optional[_builtins.bool]]] = None,
+                                    outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    parallelism: pulumi.Input[Optional[Optional[_builtins.int]]] = None,
+                                    prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    regex: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    required_outputs: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    resources: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    secret_config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                                    timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                                    workspaces: pulumi.Input[Optional[Optional[Sequence[_builtins.str]]]] = None,
+                                    opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetWorkspaceReferencesResult]:
+    """
+    Access the state of several workspaces of any backend Terraform supports, configured as in getBackendReference. The backend is configured once, and the workspaces are read concurrently.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.bool include_resources: Whether to return the resource instances recorded in the state. They are left out by default, as states can hold many of them.
+    :param Sequence[_builtins.str] outputs: The names of the outputs to return. When set, only these outputs and those in requiredOutputs are returned. Names the state does not contain are skipped.
+    :param _builtins.int parallelism: The most workspaces read at the same time.
+    :param _builtins.str prefix: Only read the workspaces whose name starts with this prefix. Conflicts with workspaces.
+    :param _builtins.str regex: Only read the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names. Conflicts with workspaces.
+    :param Sequence[_builtins.str] required_outputs: The names of the outputs the state must contain. Reading a state missing any of them fails, naming the missing outputs.
+    :param Sequence[_builtins.str] resources: The addresses of the resources or modules whose instances to return, e.g. aws_subnet.private or module.network. When set, only those instances are returned, and includeResources is implied.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    :param Sequence[_builtins.str] workspaces: The workspaces to read. When unset, every workspace of the backend matching prefix and regex is read.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['env'] = env
+    __args__['includeResources'] = include_resources
+    __args__['outputs'] = outputs
+    __args__['parallelism'] = parallelism
+    __args__['prefix'] = prefix
+    __args__['regex'] = regex
+    __args__['requiredOutputs'] = required_outputs
+    __args__['resources'] = resources
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    __args__['workspaces'] = workspaces
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:getWorkspaceReferences', __args__, opts=opts, typ=GetWorkspaceReferencesResult)
+    return __ret__.apply(lambda __response__: GetWorkspaceReferencesResult(
+        errors=pulumi.get(__response__, 'errors'),
+        workspaces=pulumi.get(__response__, 'workspaces')))
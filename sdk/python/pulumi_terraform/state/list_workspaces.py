@@ -0,0 +1,111 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+
+__all__ = [
+    'ListWorkspacesResult',
+    'AwaitableListWorkspacesResult',
+    'list_workspaces',
+    'list_workspaces_output',
+]
+
+@pulumi.output_type
+class ListWorkspacesResult:
+    def __init__(__self__, workspaces=None):
+        if workspaces and not isinstance(workspaces, list):
+            raise TypeError("Expected argument 'workspaces' to be a list")
+        pulumi.set(__self__, "workspaces", workspaces)
+
+    @_builtins.property
+    @pulumi.getter
+    def workspaces(self) -> Sequence[_builtins.str]:
+        """
+        The names of the workspaces, sorted. They can be passed as the workspace argument of the state reference functions.
+        """
+        return pulumi.get(self, "workspaces")
+
+
+class AwaitableListWorkspacesResult(ListWorkspacesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ListWorkspacesResult(
+            workspaces=self.workspaces)
+
+
+def list_workspaces(backend_type: Optional[_builtins.str] = None,
+                    config: Optional[Mapping[str, Any]] = None,
+                    env: Optional[Mapping[str, _builtins.str]] = None,
+                    prefix: Optional[_builtins.str] = None,
+                    regex: Optional[_builtins.str] = None,
+                    secret_config: Optional[Mapping[str, Any]] = None,
+                    timeout: Optional[_builtins.str] = None,
+                    opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableListWorkspacesResult:
+    """
+    List the workspaces of any backend Terraform supports, configured with the same arguments as the backend block in Terraform.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str prefix: Only list the workspaces whose name starts with this prefix.
+    :param _builtins.str regex: Only list the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['env'] = env
+    __args__['prefix'] = prefix
+    __args__['regex'] = regex
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('terraform:state:listWorkspaces', __args__, opts=opts, typ=ListWorkspacesResult).value
+
+    return AwaitableListWorkspacesResult(
+        workspaces=pulumi.get(__ret__, 'workspaces'))
+def list_workspaces_output(backend_type: pulumi.Input[Optional[_builtins.str]] = None,
+                           config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                           env: pulumi.Input[Optional[Optional[Mapping[str, _builtins.str]]]] = None,
+                           prefix: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                           regex: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                           secret_config: pulumi.Input[Optional[Optional[Mapping[str, Any]]]] = None,
+                           timeout: pulumi.Input[Optional[Optional[_builtins.str]]] = None,
+                           opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[ListWorkspacesResult]:
+    """
+    List the workspaces of any backend Terraform supports, configured with the same arguments as the backend block in Terraform.
+
+    :param _builtins.str backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+    :param Mapping[str, Any] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+    :param Mapping[str, _builtins.str] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+    :param _builtins.str prefix: Only list the workspaces whose name starts with this prefix.
+    :param _builtins.str regex: Only list the workspaces whose name matches this regular expression, in RE2 syntax. The expression is not anchored, so use ^ and $ to match whole names.
+    :param Mapping[str, Any] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+    :param _builtins.str timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+    """
+    __args__ = dict()
+    __args__['backendType'] = backend_type
+    __args__['config'] = config
+    __args__['env'] = env
+    __args__['prefix'] = prefix
+    __args__['regex'] = regex
+    __args__['secretConfig'] = secret_config
+    __args__['timeout'] = timeout
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('terraform:state:listWorkspaces', __args__, opts=opts, typ=ListWorkspacesResult)
+    return __ret__.apply(lambda __response__: ListWorkspacesResult(
+        workspaces=pulumi.get(__response__, 'workspaces')))
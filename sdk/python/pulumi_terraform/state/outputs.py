@@ -0,0 +1,735 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+from . import outputs
+
+__all__ = [
+    'AzureRMDefaults',
+    'GcsDefaults',
+    'RemoteDefaults',
+    'S3Defaults',
+    'StateReferenceOutputs',
+    'StateResource',
+]
+
+@pulumi.output_type
+class AzureRMDefaults(dict):
+    def __init__(__self__, *,
+                 client_certificate_password: Optional[_builtins.str] = None,
+                 client_certificate_path: Optional[_builtins.str] = None,
+                 client_id: Optional[_builtins.str] = None,
+                 client_secret: Optional[_builtins.str] = None,
+                 endpoint: Optional[_builtins.str] = None,
+                 environment: Optional[_builtins.str] = None,
+                 metadata_host: Optional[_builtins.str] = None,
+                 msi_endpoint: Optional[_builtins.str] = None,
+                 oidc_request_token: Optional[_builtins.str] = None,
+                 oidc_request_url: Optional[_builtins.str] = None,
+                 oidc_token: Optional[_builtins.str] = None,
+                 oidc_token_file_path: Optional[_builtins.str] = None,
+                 subscription_id: Optional[_builtins.str] = None,
+                 tenant_id: Optional[_builtins.str] = None,
+                 use_azuread_auth: Optional[_builtins.bool] = None,
+                 use_msi: Optional[_builtins.bool] = None,
+                 use_oidc: Optional[_builtins.bool] = None):
+        """
+        :param _builtins.str client_certificate_password: The password of the default client certificate.
+        :param _builtins.str client_certificate_path: The default path to the PFX file used as the client certificate.
+        :param _builtins.str client_id: The default client ID to authenticate as.
+        :param _builtins.str client_secret: The default client secret used for service principal authentication.
+        :param _builtins.str endpoint: The default custom endpoint for the Azure Resource Manager API.
+        :param _builtins.str environment: The default Azure cloud environment.
+        :param _builtins.str metadata_host: The default hostname of the Azure metadata service.
+        :param _builtins.str msi_endpoint: The default endpoint of the Managed Service Identity.
+        :param _builtins.str oidc_request_token: The default bearer token for requests to oidcRequestUrl.
+        :param _builtins.str oidc_request_url: The default URL of the OIDC provider to request an ID token from.
+        :param _builtins.str oidc_token: The default JWT token for OIDC authentication.
+        :param _builtins.str oidc_token_file_path: The default path to a file containing a JWT token for OIDC authentication.
+        :param _builtins.str subscription_id: The default subscription ID holding the storage account.
+        :param _builtins.str tenant_id: The default tenant ID to authenticate against.
+        :param _builtins.bool use_azuread_auth: Whether to authenticate against the storage container with AzureAD by default.
+        :param _builtins.bool use_msi: Whether to authenticate using Managed Service Identity by default.
+        :param _builtins.bool use_oidc: Whether to authenticate using OIDC by default.
+        """
+        if client_certificate_password is not None:
+            pulumi.set(__self__, "client_certificate_password", client_certificate_password)
+        if client_certificate_path is not None:
+            pulumi.set(__self__, "client_certificate_path", client_certificate_path)
+        if client_id is not None:
+            pulumi.set(__self__, "client_id", client_id)
+        if client_secret is not None:
+            pulumi.set(__self__, "client_secret", client_secret)
+        if endpoint is not None:
+            pulumi.set(__self__, "endpoint", endpoint)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if metadata_host is not None:
+            pulumi.set(__self__, "metadata_host", metadata_host)
+        if msi_endpoint is not None:
+            pulumi.set(__self__, "msi_endpoint", msi_endpoint)
+        if oidc_request_token is not None:
+            pulumi.set(__self__, "oidc_request_token", oidc_request_token)
+        if oidc_request_url is not None:
+            pulumi.set(__self__, "oidc_request_url", oidc_request_url)
+        if oidc_token is not None:
+            pulumi.set(__self__, "oidc_token", oidc_token)
+        if oidc_token_file_path is not None:
+            pulumi.set(__self__, "oidc_token_file_path", oidc_token_file_path)
+        if subscription_id is not None:
+            pulumi.set(__self__, "subscription_id", subscription_id)
+        if tenant_id is not None:
+            pulumi.set(__self__, "tenant_id", tenant_id)
+        if use_azuread_auth is not None:
+            pulumi.set(__self__, "use_azuread_auth", use_azuread_auth)
+        if use_msi is not None:
+            pulumi.set(__self__, "use_msi", use_msi)
+        if use_oidc is not None:
+            pulumi.set(__self__, "use_oidc", use_oidc)
+
+    @_builtins.property
+    @pulumi.getter(name="clientCertificatePassword")
+    def client_certificate_password(self) -> Optional[_builtins.str]:
+        """
+        The password of the default client certificate.
+        """
+        return pulumi.get(self, "client_certificate_password")
+
+    @_builtins.property
+    @pulumi.getter(name="clientCertificatePath")
+    def client_certificate_path(self) -> Optional[_builtins.str]:
+        """
+        The default path to the PFX file used as the client certificate.
+        """
+        return pulumi.get(self, "client_certificate_path")
+
+    @_builtins.property
+    @pulumi.getter(name="clientId")
+    def client_id(self) -> Optional[_builtins.str]:
+        """
+        The default client ID to authenticate as.
+        """
+        return pulumi.get(self, "client_id")
+
+    @_builtins.property
+    @pulumi.getter(name="clientSecret")
+    def client_secret(self) -> Optional[_builtins.str]:
+        """
+        The default client secret used for service principal authentication.
+        """
+        return pulumi.get(self, "client_secret")
+
+    @_builtins.property
+    @pulumi.getter
+    def endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default custom endpoint for the Azure Resource Manager API.
+        """
+        return pulumi.get(self, "endpoint")
+
+    @_builtins.property
+    @pulumi.getter
+    def environment(self) -> Optional[_builtins.str]:
+        """
+        The default Azure cloud environment.
+        """
+        return pulumi.get(self, "environment")
+
+    @_builtins.property
+    @pulumi.getter(name="metadataHost")
+    def metadata_host(self) -> Optional[_builtins.str]:
+        """
+        The default hostname of the Azure metadata service.
+        """
+        return pulumi.get(self, "metadata_host")
+
+    @_builtins.property
+    @pulumi.getter(name="msiEndpoint")
+    def msi_endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default endpoint of the Managed Service Identity.
+        """
+        return pulumi.get(self, "msi_endpoint")
+
+    @_builtins.property
+    @pulumi.getter(name="oidcRequestToken")
+    def oidc_request_token(self) -> Optional[_builtins.str]:
+        """
+        The default bearer token for requests to oidcRequestUrl.
+        """
+        return pulumi.get(self, "oidc_request_token")
+
+    @_builtins.property
+    @pulumi.getter(name="oidcRequestUrl")
+    def oidc_request_url(self) -> Optional[_builtins.str]:
+        """
+        The default URL of the OIDC provider to request an ID token from.
+        """
+        return pulumi.get(self, "oidc_request_url")
+
+    @_builtins.property
+    @pulumi.getter(name="oidcToken")
+    def oidc_token(self) -> Optional[_builtins.str]:
+        """
+        The default JWT token for OIDC authentication.
+        """
+        return pulumi.get(self, "oidc_token")
+
+    @_builtins.property
+    @pulumi.getter(name="oidcTokenFilePath")
+    def oidc_token_file_path(self) -> Optional[_builtins.str]:
+        """
+        The default path to a file containing a JWT token for OIDC authentication.
+        """
+        return pulumi.get(self, "oidc_token_file_path")
+
+    @_builtins.property
+    @pulumi.getter(name="subscriptionId")
+    def subscription_id(self) -> Optional[_builtins.str]:
+        """
+        The default subscription ID holding the storage account.
+        """
+        return pulumi.get(self, "subscription_id")
+
+    @_builtins.property
+    @pulumi.getter(name="tenantId")
+    def tenant_id(self) -> Optional[_builtins.str]:
+        """
+        The default tenant ID to authenticate against.
+        """
+        return pulumi.get(self, "tenant_id")
+
+    @_builtins.property
+    @pulumi.getter(name="useAzureadAuth")
+    def use_azuread_auth(self) -> Optional[_builtins.bool]:
+        """
+        Whether to authenticate against the storage container with AzureAD by default.
+        """
+        return pulumi.get(self, "use_azuread_auth")
+
+    @_builtins.property
+    @pulumi.getter(name="useMsi")
+    def use_msi(self) -> Optional[_builtins.bool]:
+        """
+        Whether to authenticate using Managed Service Identity by default.
+        """
+        return pulumi.get(self, "use_msi")
+
+    @_builtins.property
+    @pulumi.getter(name="useOidc")
+    def use_oidc(self) -> Optional[_builtins.bool]:
+        """
+        Whether to authenticate using OIDC by default.
+        """
+        return pulumi.get(self, "use_oidc")
+
+
+@pulumi.output_type
+class GcsDefaults(dict):
+    def __init__(__self__, *,
+                 access_token: Optional[_builtins.str] = None,
+                 credentials: Optional[_builtins.str] = None,
+                 impersonate_service_account: Optional[_builtins.str] = None,
+                 impersonate_service_account_delegates: Optional[Sequence[_builtins.str]] = None,
+                 storage_custom_endpoint: Optional[_builtins.str] = None):
+        """
+        :param _builtins.str access_token: The default temporary OAuth 2.0 access token.
+        :param _builtins.str credentials: The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+        :param _builtins.str impersonate_service_account: The service account to impersonate by default.
+        :param Sequence[_builtins.str] impersonate_service_account_delegates: The default delegation chain for impersonating impersonateServiceAccount.
+        :param _builtins.str storage_custom_endpoint: The default custom endpoint for the Cloud Storage API.
+        """
+        if access_token is not None:
+            pulumi.set(__self__, "access_token", access_token)
+        if credentials is not None:
+            pulumi.set(__self__, "credentials", credentials)
+        if impersonate_service_account is not None:
+            pulumi.set(__self__, "impersonate_service_account", impersonate_service_account)
+        if impersonate_service_account_delegates is not None:
+            pulumi.set(__self__, "impersonate_service_account_delegates", impersonate_service_account_delegates)
+        if storage_custom_endpoint is not None:
+            pulumi.set(__self__, "storage_custom_endpoint", storage_custom_endpoint)
+
+    @_builtins.property
+    @pulumi.getter(name="accessToken")
+    def access_token(self) -> Optional[_builtins.str]:
+        """
+        The default temporary OAuth 2.0 access token.
+        """
+        return pulumi.get(self, "access_token")
+
+    @_builtins.property
+    @pulumi.getter
+    def credentials(self) -> Optional[_builtins.str]:
+        """
+        The default path to, or the contents of, a Google Cloud service account key file in JSON format.
+        """
+        return pulumi.get(self, "credentials")
+
+    @_builtins.property
+    @pulumi.getter(name="impersonateServiceAccount")
+    def impersonate_service_account(self) -> Optional[_builtins.str]:
+        """
+        The service account to impersonate by default.
+        """
+        return pulumi.get(self, "impersonate_service_account")
+
+    @_builtins.property
+    @pulumi.getter(name="impersonateServiceAccountDelegates")
+    def impersonate_service_account_delegates(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The default delegation chain for impersonating impersonateServiceAccount.
+        """
+        return pulumi.get(self, "impersonate_service_account_delegates")
+
+    @_builtins.property
+    @pulumi.getter(name="storageCustomEndpoint")
+    def storage_custom_endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default custom endpoint for the Cloud Storage API.
+        """
+        return pulumi.get(self, "storage_custom_endpoint")
+
+
+@pulumi.output_type
+class RemoteDefaults(dict):
+    def __init__(__self__, *,
+                 hostname: Optional[_builtins.str] = None,
+                 token: Optional[_builtins.str] = None):
+        """
+        :param _builtins.str hostname: The default hostname to connect to.
+        :param _builtins.str token: The default token used to authenticate with the backend.
+        """
+        if hostname is not None:
+            pulumi.set(__self__, "hostname", hostname)
+        if token is not None:
+            pulumi.set(__self__, "token", token)
+
+    @_builtins.property
+    @pulumi.getter
+    def hostname(self) -> Optional[_builtins.str]:
+        """
+        The default hostname to connect to.
+        """
+        return pulumi.get(self, "hostname")
+
+    @_builtins.property
+    @pulumi.getter
+    def token(self) -> Optional[_builtins.str]:
+        """
+        The default token used to authenticate with the backend.
+        """
+        return pulumi.get(self, "token")
+
+
+@pulumi.output_type
+class S3Defaults(dict):
+    def __init__(__self__, *,
+                 access_key: Optional[_builtins.str] = None,
+                 assume_role_duration_seconds: Optional[_builtins.int] = None,
+                 endpoint: Optional[_builtins.str] = None,
+                 external_id: Optional[_builtins.str] = None,
+                 force_path_style: Optional[_builtins.bool] = None,
+                 iam_endpoint: Optional[_builtins.str] = None,
+                 max_retries: Optional[_builtins.int] = None,
+                 profile: Optional[_builtins.str] = None,
+                 region: Optional[_builtins.str] = None,
+                 role_arn: Optional[_builtins.str] = None,
+                 secret_key: Optional[_builtins.str] = None,
+                 session_name: Optional[_builtins.str] = None,
+                 shared_credentials_file: Optional[_builtins.str] = None,
+                 skip_credentials_validation: Optional[_builtins.bool] = None,
+                 skip_metadata_api_check: Optional[_builtins.bool] = None,
+                 skip_region_validation: Optional[_builtins.bool] = None,
+                 sts_endpoint: Optional[_builtins.str] = None,
+                 token: Optional[_builtins.str] = None):
+        """
+        :param _builtins.str access_key: The default AWS access key.
+        :param _builtins.int assume_role_duration_seconds: The default duration, in seconds, of the assume role session.
+        :param _builtins.str endpoint: The default custom endpoint for the S3 API.
+        :param _builtins.str external_id: The default external ID to use when assuming the role.
+        :param _builtins.bool force_path_style: Whether to force path-style addressing by default.
+        :param _builtins.str iam_endpoint: The default custom endpoint for the IAM API.
+        :param _builtins.int max_retries: The default maximum number of times an AWS API request is retried.
+        :param _builtins.str profile: The default AWS profile name.
+        :param _builtins.str region: The default AWS region of the S3 bucket.
+        :param _builtins.str role_arn: The ARN of the IAM Role assumed by default in order to read the state.
+        :param _builtins.str secret_key: The default AWS secret key.
+        :param _builtins.str session_name: The default session name to use when assuming the role.
+        :param _builtins.str shared_credentials_file: The default path to a shared credentials file.
+        :param _builtins.bool skip_credentials_validation: Whether to skip the credentials validation by default.
+        :param _builtins.bool skip_metadata_api_check: Whether to skip the AWS Metadata API check by default.
+        :param _builtins.bool skip_region_validation: Whether to skip static validation of the region name by default.
+        :param _builtins.str sts_endpoint: The default custom endpoint for the STS API.
+        :param _builtins.str token: The default AWS session token.
+        """
+        if access_key is not None:
+            pulumi.set(__self__, "access_key", access_key)
+        if assume_role_duration_seconds is not None:
+            pulumi.set(__self__, "assume_role_duration_seconds", assume_role_duration_seconds)
+        if endpoint is not None:
+            pulumi.set(__self__, "endpoint", endpoint)
+        if external_id is not None:
+            pulumi.set(__self__, "external_id", external_id)
+        if force_path_style is not None:
+            pulumi.set(__self__, "force_path_style", force_path_style)
+        if iam_endpoint is not None:
+            pulumi.set(__self__, "iam_endpoint", iam_endpoint)
+        if max_retries is not None:
+            pulumi.set(__self__, "max_retries", max_retries)
+        if profile is not None:
+            pulumi.set(__self__, "profile", profile)
+        if region is not None:
+            pulumi.set(__self__, "region", region)
+        if role_arn is not None:
+            pulumi.set(__self__, "role_arn", role_arn)
+        if secret_key is not None:
+            pulumi.set(__self__, "secret_key", secret_key)
+        if session_name is not None:
+            pulumi.set(__self__, "session_name", session_name)
+        if shared_credentials_file is not None:
+            pulumi.set(__self__, "shared_credentials_file", shared_credentials_file)
+        if skip_credentials_validation is not None:
+            pulumi.set(__self__, "skip_credentials_validation", skip_credentials_validation)
+        if skip_metadata_api_check is not None:
+            pulumi.set(__self__, "skip_metadata_api_check", skip_metadata_api_check)
+        if skip_region_validation is not None:
+            pulumi.set(__self__, "skip_region_validation", skip_region_validation)
+        if sts_endpoint is not None:
+            pulumi.set(__self__, "sts_endpoint", sts_endpoint)
+        if token is not None:
+            pulumi.set(__self__, "token", token)
+
+    @_builtins.property
+    @pulumi.getter(name="accessKey")
+    def access_key(self) -> Optional[_builtins.str]:
+        """
+        The default AWS access key.
+        """
+        return pulumi.get(self, "access_key")
+
+    @_builtins.property
+    @pulumi.getter(name="assumeRoleDurationSeconds")
+    def assume_role_duration_seconds(self) -> Optional[_builtins.int]:
+        """
+        The default duration, in seconds, of the assume role session.
+        """
+        return pulumi.get(self, "assume_role_duration_seconds")
+
+    @_builtins.property
+    @pulumi.getter
+    def endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default custom endpoint for the S3 API.
+        """
+        return pulumi.get(self, "endpoint")
+
+    @_builtins.property
+    @pulumi.getter(name="externalId")
+    def external_id(self) -> Optional[_builtins.str]:
+        """
+        The default external ID to use when assuming the role.
+        """
+        return pulumi.get(self, "external_id")
+
+    @_builtins.property
+    @pulumi.getter(name="forcePathStyle")
+    def force_path_style(self) -> Optional[_builtins.bool]:
+        """
+        Whether to force path-style addressing by default.
+        """
+        return pulumi.get(self, "force_path_style")
+
+    @_builtins.property
+    @pulumi.getter(name="iamEndpoint")
+    def iam_endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default custom endpoint for the IAM API.
+        """
+        return pulumi.get(self, "iam_endpoint")
+
+    @_builtins.property
+    @pulumi.getter(name="maxRetries")
+    def max_retries(self) -> Optional[_builtins.int]:
+        """
+        The default maximum number of times an AWS API request is retried.
+        """
+        return pulumi.get(self, "max_retries")
+
+    @_builtins.property
+    @pulumi.getter
+    def profile(self) -> Optional[_builtins.str]:
+        """
+        The default AWS profile name.
+        """
+        return pulumi.get(self, "profile")
+
+    @_builtins.property
+    @pulumi.getter
+    def region(self) -> Optional[_builtins.str]:
+        """
+        The default AWS region of the S3 bucket.
+        """
+        return pulumi.get(self, "region")
+
+    @_builtins.property
+    @pulumi.getter(name="roleArn")
+    def role_arn(self) -> Optional[_builtins.str]:
+        """
+        The ARN of the IAM Role assumed by default in order to read the state.
+        """
+        return pulumi.get(self, "role_arn")
+
+    @_builtins.property
+    @pulumi.getter(name="secretKey")
+    def secret_key(self) -> Optional[_builtins.str]:
+        """
+        The default AWS secret key.
+        """
+        return pulumi.get(self, "secret_key")
+
+    @_builtins.property
+    @pulumi.getter(name="sessionName")
+    def session_name(self) -> Optional[_builtins.str]:
+        """
+        The default session name to use when assuming the role.
+        """
+        return pulumi.get(self, "session_name")
+
+    @_builtins.property
+    @pulumi.getter(name="sharedCredentialsFile")
+    def shared_credentials_file(self) -> Optional[_builtins.str]:
+        """
+        The default path to a shared credentials file.
+        """
+        return pulumi.get(self, "shared_credentials_file")
+
+    @_builtins.property
+    @pulumi.getter(name="skipCredentialsValidation")
+    def skip_credentials_validation(self) -> Optional[_builtins.bool]:
+        """
+        Whether to skip the credentials validation by default.
+        """
+        return pulumi.get(self, "skip_credentials_validation")
+
+    @_builtins.property
+    @pulumi.getter(name="skipMetadataApiCheck")
+    def skip_metadata_api_check(self) -> Optional[_builtins.bool]:
+        """
+        Whether to skip the AWS Metadata API check by default.
+        """
+        return pulumi.get(self, "skip_metadata_api_check")
+
+    @_builtins.property
+    @pulumi.getter(name="skipRegionValidation")
+    def skip_region_validation(self) -> Optional[_builtins.bool]:
+        """
+        Whether to skip static validation of the region name by default.
+        """
+        return pulumi.get(self, "skip_region_validation")
+
+    @_builtins.property
+    @pulumi.getter(name="stsEndpoint")
+    def sts_endpoint(self) -> Optional[_builtins.str]:
+        """
+        The default custom endpoint for the STS API.
+        """
+        return pulumi.get(self, "sts_endpoint")
+
+    @_builtins.property
+    @pulumi.getter
+    def token(self) -> Optional[_builtins.str]:
+        """
+        The default AWS session token.
+        """
+        return pulumi.get(self, "token")
+
+
+@pulumi.output_type
+class StateReferenceOutputs(dict):
+    """
+    The result of fetching from a Terraform state store.
+    """
+    def __init__(__self__, *,
+                 lineage: _builtins.str,
+                 outputs: Mapping[str, Any],
+                 serial: _builtins.int,
+                 resources: Optional[Sequence['outputs.StateResource']] = None,
+                 state_format_version: Optional[_builtins.int] = None,
+                 terraform_version: Optional[_builtins.str] = None):
+        """
+        The result of fetching from a Terraform state store.
+
+        :param _builtins.str lineage: The lineage of the state snapshot that was read, assigned when the state was first created.
+        :param Mapping[str, Any] outputs: The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        :param _builtins.int serial: The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        :param Sequence['StateResource'] resources: The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        :param _builtins.int state_format_version: The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        :param _builtins.str terraform_version: The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        pulumi.set(__self__, "lineage", lineage)
+        pulumi.set(__self__, "outputs", outputs)
+        pulumi.set(__self__, "serial", serial)
+        if resources is not None:
+            pulumi.set(__self__, "resources", resources)
+        if state_format_version is not None:
+            pulumi.set(__self__, "state_format_version", state_format_version)
+        if terraform_version is not None:
+            pulumi.set(__self__, "terraform_version", terraform_version)
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> _builtins.str:
+        """
+        The lineage of the state snapshot that was read, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> Mapping[str, Any]:
+        """
+        The outputs displayed from Terraform state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> _builtins.int:
+        """
+        The serial of the state snapshot that was read. Of two snapshots with the same lineage, the one with the higher serial is newer.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter
+    def resources(self) -> Optional[Sequence['outputs.StateResource']]:
+        """
+        The resource instances recorded in Terraform state, across all modules. Attributes Terraform marks as sensitive are returned as secrets. Only returned when includeResources or resources is set.
+        """
+        return pulumi.get(self, "resources")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> Optional[_builtins.int]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> Optional[_builtins.str]:
+        """
+        The version of Terraform that wrote the state snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+
+@pulumi.output_type
+class StateResource(dict):
+    def __init__(__self__, *,
+                 address: _builtins.str,
+                 attributes: Mapping[str, Any],
+                 mode: _builtins.str,
+                 module: _builtins.str,
+                 name: _builtins.str,
+                 provider: _builtins.str,
+                 type: _builtins.str,
+                 index_key: Optional[Any] = None):
+        """
+        :param _builtins.str address: The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+        :param Mapping[str, Any] attributes: The attributes of the instance, as recorded in state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        :param _builtins.str mode: managed for resources and data for data sources.
+        :param _builtins.str module: The address of the module instance holding the resource. Empty for the root module.
+        :param _builtins.str name: The name of the resource in its module, e.g. private.
+        :param _builtins.str provider: The provider configuration that last managed the resource, e.g. provider["registry.terraform.io/hashicorp/aws"].
+        :param _builtins.str type: The resource type, e.g. aws_subnet.
+        :param Any index_key: The count index or for_each key of the instance. Unset when the resource uses neither count nor for_each.
+        """
+        pulumi.set(__self__, "address", address)
+        pulumi.set(__self__, "attributes", attributes)
+        pulumi.set(__self__, "mode", mode)
+        pulumi.set(__self__, "module", module)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "provider", provider)
+        pulumi.set(__self__, "type", type)
+        if index_key is not None:
+            pulumi.set(__self__, "index_key", index_key)
+
+    @_builtins.property
+    @pulumi.getter
+    def address(self) -> _builtins.str:
+        """
+        The absolute address of the instance, e.g. module.vpc.aws_subnet.private[0].
+        """
+        return pulumi.get(self, "address")
+
+    @_builtins.property
+    @pulumi.getter
+    def attributes(self) -> Mapping[str, Any]:
+        """
+        The attributes of the instance, as recorded in state. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "attributes")
+
+    @_builtins.property
+    @pulumi.getter
+    def mode(self) -> _builtins.str:
+        """
+        managed for resources and data for data sources.
+        """
+        return pulumi.get(self, "mode")
+
+    @_builtins.property
+    @pulumi.getter
+    def module(self) -> _builtins.str:
+        """
+        The address of the module instance holding the resource. Empty for the root module.
+        """
+        return pulumi.get(self, "module")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the resource in its module, e.g. private.
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> _builtins.str:
+        """
+        The provider configuration that last managed the resource, e.g. provider["registry.terraform.io/hashicorp/aws"].
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter
+    def type(self) -> _builtins.str:
+        """
+        The resource type, e.g. aws_subnet.
+        """
+        return pulumi.get(self, "type")
+
+    @_builtins.property
+    @pulumi.getter(name="indexKey")
+    def index_key(self) -> Optional[Any]:
+        """
+        The count index or for_each key of the instance. Unset when the resource uses neither count nor for_each.
+        """
+        return pulumi.get(self, "index_key")
+
+
@@ -0,0 +1,361 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from .. import _utilities
+
+__all__ = ['ReferenceArgs', 'Reference']
+
+@pulumi.input_type
+class ReferenceArgs:
+    def __init__(__self__, *,
+                 backend_type: pulumi.Input[_builtins.str],
+                 config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 env: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 required_outputs: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 secret_config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None,
+                 workspace: pulumi.Input[Optional[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a Reference resource.
+
+        :param pulumi.Input[_builtins.str] backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+        :param pulumi.Input[Mapping[str, Any]] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] required_outputs: The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+        :param pulumi.Input[Mapping[str, Any]] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+        :param pulumi.Input[_builtins.str] timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+        :param pulumi.Input[_builtins.str] workspace: The Terraform workspace to read state from.
+        """
+        pulumi.set(__self__, "backend_type", backend_type)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if required_outputs is not None:
+            pulumi.set(__self__, "required_outputs", required_outputs)
+        if secret_config is not None:
+            pulumi.set(__self__, "secret_config", secret_config)
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+        if workspace is None:
+            workspace = 'default'
+        if workspace is not None:
+            pulumi.set(__self__, "workspace", workspace)
+
+    @_builtins.property
+    @pulumi.getter(name="backendType")
+    def backend_type(self) -> pulumi.Input[_builtins.str]:
+        """
+        The type of the backend, as in the label of the backend block, e.g. s3.
+        """
+        return pulumi.get(self, "backend_type")
+
+    @backend_type.setter
+    def backend_type(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "backend_type", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Input[Optional[Mapping[str, Any]]]:
+        """
+        The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: pulumi.Input[Optional[Mapping[str, Any]]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "env", value)
+
+    @_builtins.property
+    @pulumi.getter(name="requiredOutputs")
+    def required_outputs(self) -> pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+        """
+        return pulumi.get(self, "required_outputs")
+
+    @required_outputs.setter
+    def required_outputs(self, value: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "required_outputs", value)
+
+    @_builtins.property
+    @pulumi.getter(name="secretConfig")
+    def secret_config(self) -> pulumi.Input[Optional[Mapping[str, Any]]]:
+        """
+        Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+        """
+        return pulumi.get(self, "secret_config")
+
+    @secret_config.setter
+    def secret_config(self, value: pulumi.Input[Optional[Mapping[str, Any]]]):
+        pulumi.set(self, "secret_config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "timeout", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def workspace(self) -> pulumi.Input[Optional[_builtins.str]]:
+        """
+        The Terraform workspace to read state from.
+        """
+        return pulumi.get(self, "workspace")
+
+    @workspace.setter
+    def workspace(self, value: pulumi.Input[Optional[_builtins.str]]):
+        pulumi.set(self, "workspace", value)
+
+
+@pulumi.type_token("terraform:state:Reference")
+class Reference(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 backend_type: pulumi.Input[Optional[_builtins.str]] = None,
+                 config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 env: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 required_outputs: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 secret_config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None,
+                 workspace: pulumi.Input[Optional[_builtins.str]] = None,
+                 __props__=None):
+        """
+        A snapshot of Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference.
+
+        The outputs and the serial of the snapshot are kept in the Pulumi state. When the upstream Terraform state changes, the next preview shows an update of serial, and dependents see the new outputs once it is applied.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] backend_type: The type of the backend, as in the label of the backend block, e.g. s3.
+        :param pulumi.Input[Mapping[str, Any]] config: The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] required_outputs: The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+        :param pulumi.Input[Mapping[str, Any]] secret_config: Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+        :param pulumi.Input[_builtins.str] timeout: How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+        :param pulumi.Input[_builtins.str] workspace: The Terraform workspace to read state from.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ReferenceArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A snapshot of Terraform state, stored in any backend Terraform supports. The backend is configured as in getBackendReference.
+
+        The outputs and the serial of the snapshot are kept in the Pulumi state. When the upstream Terraform state changes, the next preview shows an update of serial, and dependents see the new outputs once it is applied.
+
+        :param str resource_name: The name of the resource.
+        :param ReferenceArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ReferenceArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 backend_type: pulumi.Input[Optional[_builtins.str]] = None,
+                 config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 env: pulumi.Input[Optional[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 required_outputs: pulumi.Input[Optional[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 secret_config: pulumi.Input[Optional[Mapping[str, Any]]] = None,
+                 timeout: pulumi.Input[Optional[_builtins.str]] = None,
+                 workspace: pulumi.Input[Optional[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ReferenceArgs.__new__(ReferenceArgs)
+
+            if backend_type is None and not opts.urn:
+                raise TypeError("Missing required property 'backend_type'")
+            __props__.__dict__["backend_type"] = backend_type
+            __props__.__dict__["config"] = config
+            __props__.__dict__["env"] = None if env is None else pulumi.Output.secret(env)
+            __props__.__dict__["required_outputs"] = required_outputs
+            __props__.__dict__["secret_config"] = None if secret_config is None else pulumi.Output.secret(secret_config)
+            __props__.__dict__["timeout"] = timeout
+            if workspace is None:
+                workspace = 'default'
+            __props__.__dict__["workspace"] = workspace
+            __props__.__dict__["lineage"] = None
+            __props__.__dict__["outputs"] = None
+            __props__.__dict__["serial"] = None
+            __props__.__dict__["state_format_version"] = None
+            __props__.__dict__["terraform_version"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["env", "secretConfig"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Reference, __self__).__init__(
+            'terraform:state:Reference',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Reference':
+        """
+        Get an existing Reference resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ReferenceArgs.__new__(ReferenceArgs)
+
+        __props__.__dict__["backend_type"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["env"] = None
+        __props__.__dict__["lineage"] = None
+        __props__.__dict__["outputs"] = None
+        __props__.__dict__["required_outputs"] = None
+        __props__.__dict__["secret_config"] = None
+        __props__.__dict__["serial"] = None
+        __props__.__dict__["state_format_version"] = None
+        __props__.__dict__["terraform_version"] = None
+        __props__.__dict__["timeout"] = None
+        __props__.__dict__["workspace"] = None
+        return Reference(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="backendType")
+    def backend_type(self) -> pulumi.Output[_builtins.str]:
+        """
+        The type of the backend, as in the label of the backend block, e.g. s3.
+        """
+        return pulumi.get(self, "backend_type")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The backend configuration, keyed by the backend's argument names, e.g. {bucket = "my-state"} for s3.
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        Environment variables to set while the backend is read, overriding those of the provider process. The backend falls back to them for settings its arguments leave unset.
+        """
+        return pulumi.get(self, "env")
+
+    @_builtins.property
+    @pulumi.getter
+    def lineage(self) -> pulumi.Output[_builtins.str]:
+        """
+        The lineage of the snapshot, assigned when the state was first created.
+        """
+        return pulumi.get(self, "lineage")
+
+    @_builtins.property
+    @pulumi.getter
+    def outputs(self) -> pulumi.Output[Mapping[str, Any]]:
+        """
+        The outputs of the snapshot. Outputs Terraform marks as sensitive are secrets. Numbers are carried as 64-bit floats, so integers beyond ±9007199254740991 are returned as their exact decimal string instead. A value that crosses that bound changes type between states.
+        """
+        return pulumi.get(self, "outputs")
+
+    @_builtins.property
+    @pulumi.getter(name="requiredOutputs")
+    def required_outputs(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The names of the outputs the state must contain. Reading the state fails, naming the missing outputs, when any of them is absent.
+        """
+        return pulumi.get(self, "required_outputs")
+
+    @_builtins.property
+    @pulumi.getter(name="secretConfig")
+    def secret_config(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Backend configuration holding credentials. It is merged with config, and a key may not be set in both.
+        """
+        return pulumi.get(self, "secret_config")
+
+    @_builtins.property
+    @pulumi.getter
+    def serial(self) -> pulumi.Output[_builtins.int]:
+        """
+        The serial of the snapshot. Terraform increments it on every change to the state.
+        """
+        return pulumi.get(self, "serial")
+
+    @_builtins.property
+    @pulumi.getter(name="stateFormatVersion")
+    def state_format_version(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The version of the state file format the snapshot is stored in. Unset when the backend does not expose the raw snapshot, as with the cloud backend.
+        """
+        return pulumi.get(self, "state_format_version")
+
+    @_builtins.property
+    @pulumi.getter(name="terraformVersion")
+    def terraform_version(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The version of Terraform that wrote the snapshot. Unset when the backend does not record it.
+        """
+        return pulumi.get(self, "terraform_version")
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        How long to wait for the backend to be read, as a duration such as 30s or 2m. Falls back to the timeout of the provider configuration, and reads are unbounded when neither is set.
+        """
+        return pulumi.get(self, "timeout")
+
+    @_builtins.property
+    @pulumi.getter
+    def workspace(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The Terraform workspace to read state from.
+        """
+        return pulumi.get(self, "workspace")
+